@@ -0,0 +1,161 @@
+package govalidator
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const defaultMaxDecompressedBytes int64 = 1024 * 1024 * 10
+
+var errDecompressedTooLarge = errors.New("govalidator: decompressed request body too large")
+
+// prepareBody limit the request body to Options.MaxBodyBytes, decompress gzip and deflate encoded body
+// and buffer it if Options.RestoreBody is set
+// the returned func put the buffered body back to the request so that the next handlers can read it again
+func (v *Validator) prepareBody() (func(), error) {
+	r := v.Opts.Request
+	restore := func() {}
+	if r.Body == nil || r.Body == http.NoBody {
+		return restore, nil
+	}
+	if v.Opts.MaxBodyBytes > 0 {
+		r.Body = http.MaxBytesReader(nil, r.Body, v.Opts.MaxBodyBytes)
+	}
+	if err := v.decompressBody(); err != nil {
+		return restore, err
+	}
+	if !v.Opts.RestoreBody {
+		return restore, nil
+	}
+
+	buf, err := ioutil.ReadAll(r.Body)
+	_ = r.Body.Close()
+	if err != nil {
+		return restore, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(buf))
+	restore = func() {
+		r.Body = ioutil.NopCloser(bytes.NewReader(buf))
+	}
+	return restore, nil
+}
+
+// decompressBody replace the gzip or deflate encoded request body with the decompressed one
+// the decompressed size is limited to Options.MaxDecompressedBytes to prevent zip bomb
+func (v *Validator) decompressBody() error {
+	r := v.Opts.Request
+	encoding := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding")))
+	var rc io.ReadCloser
+	switch encoding {
+	case "", "identity":
+		return nil
+	case "gzip", "x-gzip":
+		gr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return err
+		}
+		rc = gr
+	case "deflate":
+		// deflate is zlib wrapped by the spec but some clients send raw deflate
+		br := bufio.NewReader(r.Body)
+		if isZlibHeader(br) {
+			zr, err := zlib.NewReader(br)
+			if err != nil {
+				return err
+			}
+			rc = zr
+		} else {
+			rc = flate.NewReader(br)
+		}
+	default:
+		return fmt.Errorf("%s: Content-Encoding %s", http.StatusText(http.StatusUnsupportedMediaType), encoding)
+	}
+
+	limit := defaultMaxDecompressedBytes
+	if v.Opts.MaxDecompressedBytes > 0 {
+		limit = v.Opts.MaxDecompressedBytes
+	}
+	r.Body = &decompressedBody{ReadCloser: rc, body: r.Body, n: limit}
+	r.Header.Del("Content-Encoding")
+	r.ContentLength = -1
+	return nil
+}
+
+// isZlibHeader check if the stream starts with a zlib header
+func isZlibHeader(br *bufio.Reader) bool {
+	h, err := br.Peek(2)
+	if err != nil {
+		return false
+	}
+	return h[0]&0x0f == 8 && (uint16(h[0])<<8|uint16(h[1]))%31 == 0
+}
+
+// decompressedBody represents a decompressed request body limited to n bytes
+type decompressedBody struct {
+	io.ReadCloser
+	body io.ReadCloser // body is the original compressed body
+	n    int64
+}
+
+// Read read at most n bytes of the decompressed body
+func (d *decompressedBody) Read(p []byte) (int, error) {
+	if d.n <= 0 {
+		// check if there is more data than the limit
+		var b [1]byte
+		if n, _ := d.ReadCloser.Read(b[:]); n > 0 {
+			return 0, errDecompressedTooLarge
+		}
+		return 0, io.EOF
+	}
+	if int64(len(p)) > d.n {
+		p = p[:d.n]
+	}
+	n, err := d.ReadCloser.Read(p)
+	d.n -= int64(n)
+	return n, err
+}
+
+// Close close both the decompressor and the original body
+func (d *decompressedBody) Close() error {
+	err := d.ReadCloser.Close()
+	if bErr := d.body.Close(); err == nil {
+		err = bErr
+	}
+	return err
+}
+
+// isBodyTooLarge check if the error is caused by reading more than Options.MaxBodyBytes
+// or Options.MaxDecompressedBytes
+func isBodyTooLarge(err error) bool {
+	var mbe *http.MaxBytesError
+	return errors.As(err, &mbe) || errors.Is(err, errDecompressedTooLarge)
+}
+
+// bodyError return the message for the error occurred while reading the request body
+func (v *Validator) bodyError(err error) string {
+	if !isBodyTooLarge(err) {
+		return err.Error()
+	}
+	if msg := v.getCustomMessage("_error", "body_size"); msg != "" {
+		return msg
+	}
+	loc := v.newLocalizer()
+	if errors.Is(err, errDecompressedTooLarge) {
+		limit := defaultMaxDecompressedBytes
+		if v.Opts.MaxDecompressedBytes > 0 {
+			limit = v.Opts.MaxDecompressedBytes
+		}
+		return loc.message("body_size.decompressed", "", "size", strconv.FormatInt(limit, 10))
+	}
+	return loc.message("body_size", "", "size", strconv.FormatInt(v.Opts.MaxBodyBytes, 10))
+}
@@ -0,0 +1,185 @@
+package govalidator
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestValidator_ValidateJSON_MaxBodyBytes(t *testing.T) {
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader(`{"name":"John Doe"}`))
+
+	data := map[string]interface{}{}
+	opts := Options{
+		Request: req,
+		Data:    &data,
+		Rules: MapData{
+			"name": []string{"required"},
+		},
+		MaxBodyBytes: 10,
+	}
+
+	validationErr := New(opts).ValidateJSON()
+	if validationErr.Get("_error") != "The request body can not be greater than 10 bytes" {
+		t.Log(validationErr)
+		t.Error("ValidateJSON failed to limit the body size")
+	}
+}
+
+func TestValidator_Validate_MaxBodyBytes(t *testing.T) {
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader("name=John+Doe"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	opts := Options{
+		Request: req,
+		Rules: MapData{
+			"name": []string{"required"},
+		},
+		Messages: MapData{
+			"_error": []string{"body_size:Too large"},
+		},
+		MaxBodyBytes: 5,
+	}
+
+	validationErr := New(opts).Validate()
+	if validationErr.Get("_error") != "Too large" {
+		t.Log(validationErr)
+		t.Error("Validate failed to limit the body size")
+	}
+}
+
+func TestValidator_ValidateJSON_RestoreBody(t *testing.T) {
+	body := `{"name":"John Doe"}`
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader(body))
+
+	data := map[string]interface{}{}
+	opts := Options{
+		Request: req,
+		Data:    &data,
+		Rules: MapData{
+			"name": []string{"required"},
+		},
+		MaxBodyBytes: 1024,
+		RestoreBody:  true,
+	}
+
+	validationErr := New(opts).ValidateJSON()
+	if len(validationErr) != 0 {
+		t.Log(validationErr)
+		t.Error("ValidateJSON failed with RestoreBody")
+	}
+	b, _ := ioutil.ReadAll(req.Body)
+	if string(b) != body {
+		t.Error("ValidateJSON failed to restore the request body")
+	}
+}
+
+func TestValidator_ValidateJSON_ContentEncoding(t *testing.T) {
+	body := []byte(`{"name":"John Doe","email":"invalid"}`)
+	compressed := map[string]func() []byte{
+		"gzip": func() []byte {
+			var buf bytes.Buffer
+			w := gzip.NewWriter(&buf)
+			w.Write(body)
+			w.Close()
+			return buf.Bytes()
+		},
+		"deflate": func() []byte {
+			var buf bytes.Buffer
+			w := zlib.NewWriter(&buf)
+			w.Write(body)
+			w.Close()
+			return buf.Bytes()
+		},
+	}
+	for encoding, compress := range compressed {
+		req, _ := http.NewRequest("POST", "http://www.example.com", bytes.NewReader(compress()))
+		req.Header.Set("Content-Encoding", encoding)
+
+		data := map[string]interface{}{}
+		opts := Options{
+			Request: req,
+			Data:    &data,
+			Rules: MapData{
+				"name":  []string{"required"},
+				"email": []string{"email"},
+			},
+		}
+		validationErr := New(opts).ValidateJSON()
+		if len(validationErr) != 1 || validationErr.Get("email") == "" {
+			t.Log(validationErr)
+			t.Errorf("ValidateJSON failed to decompress %s body", encoding)
+		}
+	}
+}
+
+func TestValidator_Validate_RawDeflate(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := flate.NewWriter(&buf, flate.DefaultCompression)
+	w.Write([]byte("name=John+Doe"))
+	w.Close()
+	req, _ := http.NewRequest("POST", "http://www.example.com", &buf)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Content-Encoding", "deflate")
+
+	opts := Options{
+		Request: req,
+		Rules: MapData{
+			"name": []string{"required", "alpha_space"},
+		},
+	}
+	validationErr := New(opts).Validate()
+	if len(validationErr) != 0 {
+		t.Log(validationErr)
+		t.Error("Validate failed to decompress raw deflate body")
+	}
+}
+
+func TestValidator_ValidateJSON_MaxDecompressedBytes(t *testing.T) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write([]byte(`{"name":"` + strings.Repeat("a", 1024*1024) + `"}`))
+	w.Close()
+	req, _ := http.NewRequest("POST", "http://www.example.com", &buf)
+	req.Header.Set("Content-Encoding", "gzip")
+
+	data := map[string]interface{}{}
+	opts := Options{
+		Request: req,
+		Data:    &data,
+		Rules: MapData{
+			"name": []string{"required"},
+		},
+		MaxBodyBytes:         1024 * 10,
+		MaxDecompressedBytes: 1024,
+	}
+	validationErr := New(opts).ValidateJSON()
+	if validationErr.Get("_error") != "The decompressed request body can not be greater than 1024 bytes" {
+		t.Log(validationErr)
+		t.Error("ValidateJSON failed to limit the decompressed body size")
+	}
+}
+
+func TestValidator_ValidateJSON_UnsupportedContentEncoding(t *testing.T) {
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader("xyz"))
+	req.Header.Set("Content-Encoding", "br")
+
+	data := map[string]interface{}{}
+	opts := Options{
+		Request: req,
+		Data:    &data,
+		Rules: MapData{
+			"name": []string{"required"},
+		},
+	}
+	validationErr := New(opts).ValidateJSON()
+	if validationErr.Get("_error") != "Unsupported Media Type: Content-Encoding br" {
+		t.Log(validationErr)
+		t.Error("ValidateJSON failed to reject unsupported content encoding")
+	}
+}
@@ -0,0 +1,90 @@
+package govalidator
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// formMediaTypes represents the media types which are validated as form-data, x-www-form-urlencoded and query params
+var formMediaTypes = []string{"", "application/x-www-form-urlencoded", "multipart/form-data"}
+
+var decodersFuncMap = make(map[string]func(io.Reader, interface{}) error)
+
+// AddDecoder help to add body decoder for a content type used by ValidateRequest
+// First argument it takes the media type and second arg a func
+// Second arg must have this signature below
+// fn func(body io.Reader, data interface{}) error
+// e.g: AddDecoder("application/cbor", func(body io.Reader, data interface{}) error { return cbor.NewDecoder(body).Decode(data) })
+// the decoder of a registered media type including the built-in JSON and XML decoders is replaced,
+// the form media types can not be decoded
+func AddDecoder(mediaType string, fn func(body io.Reader, data interface{}) error) {
+	mediaType = strings.ToLower(mediaType)
+	if isFormMediaType(mediaType) {
+		panic(fmt.Errorf("govalidator: decoder for %s is already defined", mediaType))
+	}
+	decodersFuncMap[mediaType] = fn
+}
+
+// getDecoder return the decoder of the media type
+// structured syntax suffix like application/problem+json fall back to the base type
+func getDecoder(mediaType string) (func(io.Reader, interface{}) error, bool) {
+	if fn, ok := decodersFuncMap[mediaType]; ok {
+		return fn, true
+	}
+	if i := strings.LastIndex(mediaType, "+"); i != -1 {
+		fn, ok := decodersFuncMap["application/"+mediaType[i+1:]]
+		return fn, ok
+	}
+	return nil, false
+}
+
+// isFormMediaType check if the media type is validated as form values
+func isFormMediaType(mediaType string) bool {
+	for _, m := range formMediaTypes {
+		if m == mediaType {
+			return true
+		}
+	}
+	return false
+}
+
+// parseMediaType return the lower cased media type without params, empty content type is allowed
+func parseMediaType(contentType string) (string, error) {
+	if strings.TrimSpace(contentType) == "" {
+		return "", nil
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return mediaType, err
+}
+
+// tagIdentifierOf return the default struct tag identifier of the media type
+func tagIdentifierOf(mediaType string) string {
+	if isXMLMediaType(mediaType) {
+		return xmlTagIdentifier
+	}
+	return tagIdentifier
+}
+
+// isXMLMediaType check if the media type body is XML, e.g: application/xml or application/atom+xml
+func isXMLMediaType(mediaType string) bool {
+	return strings.HasSuffix(mediaType, "xml")
+}
+
+// unsupportedMediaType return the message for a content type which has no decoder
+func unsupportedMediaType(mediaType string) string {
+	return fmt.Sprintf("%s: %s", http.StatusText(http.StatusUnsupportedMediaType), mediaType)
+}
+
+// decodeXML decode the body using encoding/xml
+func decodeXML(body io.Reader, data interface{}) error {
+	return xml.NewDecoder(body).Decode(data)
+}
+
+func init() {
+	AddDecoder("application/xml", decodeXML)
+	AddDecoder("text/xml", decodeXML)
+}
@@ -0,0 +1,157 @@
+package govalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAddDecoder(t *testing.T) {
+	AddDecoder("application/x-test", func(body io.Reader, data interface{}) error {
+		b, err := io.ReadAll(body)
+		if err != nil {
+			return err
+		}
+		pairs := strings.SplitN(string(b), "=", 2)
+		(*data.(*map[string]interface{}))[pairs[0]] = pairs[1]
+		return nil
+	})
+
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader("email=invalid"))
+	req.Header.Set("Content-Type", "application/x-test")
+	opts := Options{
+		Request: req,
+		Rules: MapData{
+			"email": []string{"email"},
+		},
+	}
+	validationErr := New(opts).ValidateRequest()
+	if validationErr.Get("email") == "" {
+		t.Log(validationErr)
+		t.Error("ValidateRequest failed to use the registered decoder")
+	}
+}
+
+func TestAddDecoder_panic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("AddDecoder failed to panic")
+		}
+	}()
+	AddDecoder("multipart/form-data", decodeXML)
+}
+
+func TestAddDecoder_override(t *testing.T) {
+	defer AddDecoder("application/xml", decodeXML)
+	called := false
+	AddDecoder("application/xml", func(body io.Reader, data interface{}) error {
+		called = true
+		return decodeXML(body, data)
+	})
+
+	var user struct {
+		Name string `xml:"name"`
+	}
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader("<user><name>John</name></user>"))
+	req.Header.Set("Content-Type", "application/xml")
+	opts := Options{
+		Request: req,
+		Data:    &user,
+		Rules: MapData{
+			"name": []string{"required"},
+		},
+	}
+	validationErr := New(opts).ValidateRequest()
+	if len(validationErr) != 0 || !called {
+		t.Log(validationErr)
+		t.Error("AddDecoder failed to replace the built-in decoder")
+	}
+}
+
+func TestValidator_ValidateRequest(t *testing.T) {
+	type User struct {
+		Name  string `json:"name" xml:"name"`
+		Email string `json:"email" xml:"email"`
+	}
+	rules := MapData{
+		"name":  []string{"required"},
+		"email": []string{"email"},
+	}
+
+	var user User
+	body, _ := json.Marshal(map[string]string{"email": "invalid"})
+	req, _ := http.NewRequest("POST", "http://www.example.com", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	validationErr := New(Options{Request: req, Data: &user, Rules: rules}).ValidateRequest()
+	if len(validationErr) != 2 {
+		t.Log(validationErr)
+		t.Error("ValidateRequest failed for JSON body")
+	}
+
+	req, _ = http.NewRequest("POST", "http://www.example.com", strings.NewReader("name=John&email=john@mail.com"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	validationErr = New(Options{Request: req, Rules: rules}).ValidateRequest()
+	if len(validationErr) != 0 {
+		t.Log(validationErr)
+		t.Error("ValidateRequest failed for form body")
+	}
+
+	user = User{}
+	req, _ = http.NewRequest("POST", "http://www.example.com", strings.NewReader("<user><name>John</name><email>john@mail.com</email></user>"))
+	req.Header.Set("Content-Type", "application/xml")
+	validationErr = New(Options{Request: req, Data: &user, Rules: rules}).ValidateRequest()
+	if len(validationErr) != 0 || user.Name != "John" {
+		t.Log(validationErr)
+		t.Error("ValidateRequest failed for XML body")
+	}
+}
+
+func TestValidator_ValidateRequest_UnsupportedMediaType(t *testing.T) {
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader("name: John"))
+	req.Header.Set("Content-Type", "application/yaml")
+	opts := Options{
+		Request: req,
+		Rules: MapData{
+			"name": []string{"required"},
+		},
+	}
+	validationErr := New(opts).ValidateRequest()
+	if validationErr.Get("_error") != "Unsupported Media Type: application/yaml" {
+		t.Log(validationErr)
+		t.Error("ValidateRequest failed to report unsupported media type")
+	}
+}
+
+func TestValidator_ValidateRequest_MapData(t *testing.T) {
+	rules := MapData{
+		"name": []string{"required"},
+	}
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader(`{"name":"John"}`))
+	req.Header.Set("Content-Type", "application/json")
+	v := New(Options{Request: req, Rules: rules})
+	validationErr := v.ValidateRequest()
+	if len(validationErr) != 0 || v.Opts.Data != nil {
+		t.Log(validationErr)
+		t.Error("ValidateRequest failed to decode JSON body into a local map")
+	}
+
+	req, _ = http.NewRequest("POST", "http://www.example.com", strings.NewReader("<user><name>John</name></user>"))
+	req.Header.Set("Content-Type", "application/xml")
+	validationErr = New(Options{Request: req, Rules: rules}).ValidateRequest()
+	if validationErr.Get("_error") != errXMLRequireData.Error() {
+		t.Log(validationErr)
+		t.Error("ValidateRequest failed to report XML body without data")
+	}
+}
+
+func Test_getDecoder(t *testing.T) {
+	if _, ok := getDecoder("application/atom+xml"); !ok {
+		t.Error("getDecoder failed to fall back to the structured syntax suffix")
+	}
+	if _, ok := getDecoder("application/yaml"); ok {
+		t.Error("getDecoder returned decoder for unknown media type")
+	}
+}
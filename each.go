@@ -0,0 +1,89 @@
+package govalidator
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	eachRulePrefix  = "each:" // eachRulePrefix apply the rule to every element of a collection e.g: each:email
+	wildcardSuffix  = ".*"    // wildcardSuffix apply the rules of the key to every element of a collection e.g: tags.*
+	formArraySuffix = "[]"    // formArraySuffix represents PHP style multi valued form field e.g: tags[]
+)
+
+// collectionRules represents the rules validating the values of a multi valued form field as a collection
+// e.g: min:2 checks the count of tags=a&tags=b, the other rules validate every value
+var collectionRules = []string{"required", "min", "max", "between", "len", "array"}
+
+// getEachRule return the collection field and the rule to apply on every element
+// if the field is a wildcard key (tags.*) or the rule is prefixed with each: (each:email)
+func getEachRule(field, rule string) (string, string, bool) {
+	if strings.HasSuffix(field, wildcardSuffix) {
+		return strings.TrimSuffix(field, wildcardSuffix), rule, true
+	}
+	if strings.HasPrefix(rule, eachRulePrefix) {
+		return field, strings.TrimPrefix(rule, eachRulePrefix), true
+	}
+	return field, rule, false
+}
+
+// formValues return the values of the form field and if the field is multi valued
+// PHP style tags[] and indexed tags[0] naming are considered as multi valued even if a single value is provided
+func formValues(inputs url.Values, field string) ([]string, bool) {
+	if vals, ok := inputs[field]; ok {
+		return vals, len(vals) > 1
+	}
+	if vals, ok := inputs[field+formArraySuffix]; ok {
+		return vals, true
+	}
+	return formIndexedValues(inputs, field)
+}
+
+// isFormFieldExist check if the field, the PHP style tags[] or indexed tags[0] field exist in the form
+func isFormFieldExist(inputs url.Values, field string) bool {
+	field = strings.TrimSuffix(field, wildcardSuffix)
+	if _, ok := inputs[field]; ok {
+		return true
+	}
+	// multi valued is reported for the PHP style and indexed fields only if they exist
+	_, multi := formValues(inputs, field)
+	return multi
+}
+
+// validateEach validate the rule against every element of the collection using indexed field name e.g: tags.0
+// the custom message is looked up using the rule key and the element rule
+func (v *Validator) validateEach(key, field, rule string, value interface{}, errsBag url.Values, loc *localizer) {
+	if !isRuleExist(rule) {
+		panic(fmt.Errorf("govalidator: %s is not a valid rule", rule))
+	}
+	msg := v.getCustomMessage(key, rule)
+	for i, elem := range collectionElements(value) {
+		elemField := field + "." + strconv.Itoa(i)
+		validateRule(elemField, loc.attribute(elemField), rule, msg, elem, errsBag, loc.templates)
+	}
+}
+
+// collectionElements return the elements of slice or array value
+func collectionElements(value interface{}) []interface{} {
+	if vals, ok := value.([]string); ok {
+		elems := make([]interface{}, len(vals))
+		for i, v := range vals {
+			elems[i] = v
+		}
+		return elems
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil
+	}
+	elems := make([]interface{}, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		if rv.Index(i).CanInterface() {
+			elems = append(elems, rv.Index(i).Interface())
+		}
+	}
+	return elems
+}
@@ -0,0 +1,99 @@
+package govalidator
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestValidator_ValidateValues_MultiValued(t *testing.T) {
+	values := url.Values{}
+	values.Add("tags", "go")
+	values.Add("tags", "x")
+	values.Add("tags", "validator")
+	values.Add("emails[]", "john@mail.com")
+	values.Add("emails[]", "invalid")
+
+	opts := Options{
+		Rules: MapData{
+			"tags":     []string{"min:1", "max:2", "each:between:2,10"},
+			"emails":   []string{"min:3"},
+			"emails.*": []string{"email"},
+		},
+		Messages: MapData{
+			"emails.*": []string{"email:Every email must be valid"},
+		},
+	}
+
+	validationErr := New(opts).ValidateValues(values)
+	if validationErr.Get("tags") != "The tags field must be maximum 2 in size" {
+		t.Error("ValidateValues failed to validate the count of multi valued field")
+	}
+	if validationErr.Get("tags.1") == "" {
+		t.Error("ValidateValues failed to validate each: rule")
+	}
+	if validationErr.Get("emails") == "" {
+		t.Error("ValidateValues failed to validate the count of tags[] field")
+	}
+	if validationErr.Get("emails.1") != "Every email must be valid" {
+		t.Error("ValidateValues failed to validate wildcard rule")
+	}
+	if len(validationErr) != 4 {
+		t.Log(validationErr)
+		t.Error("ValidateValues reported unexpected errors")
+	}
+}
+
+func TestValidator_ValidateValues_MultiValuedRules(t *testing.T) {
+	values := url.Values{"tags": []string{"a", "b"}, "e": []string{"a@b.com", "c@d.com"}, "ids": []string{"1", "x"}}
+	opts := Options{
+		Rules: MapData{
+			"tags": []string{"alpha"},
+			"e":    []string{"required", "email"},
+			"ids":  []string{"numeric"},
+		},
+	}
+	errs := New(opts).ValidateValues(values)
+	if len(errs) != 1 || len(errs["ids"]) != 1 {
+		t.Errorf("ValidateValues failed to validate every value of multi valued field: %v", errs)
+	}
+}
+
+func TestValidator_ValidateValues_WildcardNotRequired(t *testing.T) {
+	opts := Options{
+		Rules: MapData{
+			"tags.*": []string{"alpha"},
+		},
+	}
+
+	validationErr := New(opts).ValidateValues(url.Values{})
+	if len(validationErr) != 0 {
+		t.Log(validationErr)
+		t.Error("ValidateValues failed to skip non required wildcard field")
+	}
+}
+
+func TestValidator_ValidateMap_Each(t *testing.T) {
+	data := map[string]interface{}{
+		"scores": []interface{}{10.0, 55.0, 101.0},
+	}
+	opts := Options{
+		Rules: MapData{
+			"scores": []string{"each:numeric_between:0,100"},
+		},
+	}
+
+	validationErr := New(opts).ValidateMap(data)
+	if len(validationErr) != 1 || validationErr.Get("scores.2") == "" {
+		t.Log(validationErr)
+		t.Error("ValidateMap failed to validate each: rule")
+	}
+}
+
+func TestValidator_validateEach_InvalidRule_panic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("validateEach failed to panic for invalid rule")
+		}
+	}()
+	New(Options{}).validateEach("tags", "tags", "not_exist", []string{"a"}, url.Values{}, nil)
+}
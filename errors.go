@@ -8,7 +8,9 @@ var (
 	errRequireRules         = errors.New("govalidator: provide at least rules for Validate* method")
 	errValidateArgsMismatch = errors.New("govalidator: provide at least *http.Request and rules for Validate method")
 	errInvalidArgument      = errors.New("govalidator: invalid number of argument")
+	errStringToTime         = errors.New("govalidator: unable to parse string to time")
 	errRequirePtr           = errors.New("govalidator: provide pointer to the data structure")
-	errRequireData          = errors.New("govalidator: provide non-nil data structure for ValidateStruct method")
+	errRequireData          = errors.New("govalidator: provide non-nil data structure for ValidateStruct or ValidateForm method")
 	errRequestNotAccepted   = errors.New("govalidator: cannot provide an *http.Request for ValidateStruct method")
+	errXMLRequireData       = errors.New("govalidator: provide Options.Data to decode XML body, it can not be decoded into a map")
 )
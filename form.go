@@ -0,0 +1,176 @@
+package govalidator
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// normalizeFormKeys convert the bracket notation keys of the form into dotted keys
+// e.g: user[address][city] becomes user.address.city and items[0][sku] becomes items.0.sku
+// the PHP style tags[] suffix is preserved to mark a multi valued field
+// the key matching a rule key literally e.g: user[name] is kept as is
+func normalizeFormKeys(inputs url.Values, rules MapData) url.Values {
+	normalized := make(url.Values, len(inputs))
+	for k, vals := range inputs {
+		key := k
+		if _, ok := rules[k]; !ok {
+			key = bracketToDotted(k)
+		}
+		normalized[key] = append(normalized[key], vals...)
+	}
+	return normalized
+}
+
+// bracketToDotted convert a single bracket notation key into dotted key
+// malformed keys are returned as is
+func bracketToDotted(key string) string {
+	i := strings.Index(key, "[")
+	if i <= 0 || !strings.HasSuffix(key, "]") {
+		return key
+	}
+	segs := []string{key[:i]}
+	rest := key[i:]
+	multi := false
+	for rest != "" {
+		end := strings.Index(rest, "]")
+		if rest[0] != '[' || end == -1 {
+			return key
+		}
+		seg := rest[1:end]
+		rest = rest[end+1:]
+		if seg == "" {
+			// only the last segment can be empty e.g: tags[]
+			if rest != "" {
+				return key
+			}
+			multi = true
+			continue
+		}
+		if strings.ContainsAny(seg, "[.") {
+			return key
+		}
+		segs = append(segs, seg)
+	}
+	dotted := strings.Join(segs, ".")
+	if multi {
+		dotted += formArraySuffix
+	}
+	return dotted
+}
+
+// expandFormRules expand the wildcard segments of the rule keys against the form keys
+// e.g: items.*.sku becomes items.0.sku, items.1.sku for the form items[0][sku]=X&items[1][name]=Y
+// the trailing wildcard (tags.*) is kept as it applies the rules to every value of the field
+// a required key matching no form key is kept unexpanded so the missing field is reported
+func expandFormRules(rules MapData, inputs url.Values) MapData {
+	return expandRules(rules, func(prefix string) []string {
+		return formChildSegments(inputs, prefix)
+	})
+}
+
+// expandRules expand the wildcard segments of the rule keys using the child segments of the expanded prefix
+func expandRules(rules MapData, children func(prefix string) []string) MapData {
+	expanded := make(MapData, len(rules))
+	for field, r := range rules {
+		base := strings.TrimSuffix(field, wildcardSuffix)
+		if !strings.HasPrefix(field, "file:") && isIn(strings.Split(base, "."), "*") {
+			suffix := strings.TrimPrefix(field, base)
+			keys := expandWildcard(base, children)
+			if len(keys) == 0 && isContainRequiredField(r) {
+				keys = []string{base}
+			}
+			for _, f := range keys {
+				expanded[f+suffix] = append(expanded[f+suffix], r...)
+			}
+			continue
+		}
+		expanded[field] = append(expanded[field], r...)
+	}
+	return expanded
+}
+
+// expandWildcard return the concrete keys of the pattern, every * segment is replaced with
+// the child segments found under the expanded prefix
+func expandWildcard(pattern string, children func(prefix string) []string) []string {
+	prefixes := []string{""}
+	for _, seg := range strings.Split(pattern, ".") {
+		next := make([]string, 0, len(prefixes))
+		for _, p := range prefixes {
+			if seg != "*" {
+				next = append(next, joinPath(p, seg))
+				continue
+			}
+			for _, child := range children(p) {
+				next = append(next, joinPath(p, child))
+			}
+		}
+		prefixes = next
+	}
+	return prefixes
+}
+
+// formChildSegments return the distinct segments after the prefix in the form keys
+func formChildSegments(inputs url.Values, prefix string) []string {
+	keys := make([]string, 0, len(inputs))
+	for k := range inputs {
+		keys = append(keys, strings.TrimSuffix(k, formArraySuffix))
+	}
+	return childSegments(keys, prefix)
+}
+
+// childSegments return the distinct segments after the prefix in the dotted keys
+// numeric segments are sorted by their value
+func childSegments(keys []string, prefix string) []string {
+	seen := make(map[string]struct{})
+	children := make([]string, 0)
+	for _, k := range keys {
+		if !strings.HasPrefix(k, prefix+".") {
+			continue
+		}
+		child := strings.Split(strings.TrimPrefix(k, prefix+"."), ".")[0]
+		if _, ok := seen[child]; !ok {
+			seen[child] = struct{}{}
+			children = append(children, child)
+		}
+	}
+	sort.Slice(children, func(i, j int) bool {
+		a, aErr := strconv.Atoi(children[i])
+		b, bErr := strconv.Atoi(children[j])
+		if aErr == nil && bErr == nil {
+			return a < b
+		}
+		return children[i] < children[j]
+	})
+	return children
+}
+
+// formIndexedValues return the values of the indexed keys of the field in order
+// e.g: tags.0, tags.1 for the form tags[0]=a&tags[1]=b
+func formIndexedValues(inputs url.Values, field string) ([]string, bool) {
+	var vals []string
+	found := false
+	for _, child := range formChildSegments(inputs, field) {
+		if _, err := strconv.Atoi(child); err != nil {
+			continue
+		}
+		if v, ok := inputs[field+"."+child]; ok {
+			vals = append(vals, v...)
+			found = true
+		}
+	}
+	return vals, found
+}
+
+// wildcardKey replace the numeric segments of the dotted key with wildcard
+// e.g: items.0.sku becomes items.*.sku
+func wildcardKey(key string) string {
+	segs := strings.Split(key, ".")
+	for i, seg := range segs {
+		if _, err := strconv.Atoi(seg); err == nil && i > 0 {
+			segs[i] = "*"
+		}
+	}
+	return strings.Join(segs, ".")
+}
@@ -0,0 +1,246 @@
+package govalidator
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// formDecoder decode the form values into a struct using the struct tag
+type formDecoder struct {
+	v      *Validator
+	inputs url.Values
+	tag    string
+	errs   url.Values
+	loc    *localizer // loc is built on the first error
+}
+
+// decodeForm decode the form values into data, data must be a pointer to struct
+// nested struct fields are read from dotted (address.city) or flat (city) keys
+// the conversion failures are returned keyed by the form key
+func (v *Validator) decodeForm(inputs url.Values, data interface{}, tag string) url.Values {
+	d := &formDecoder{v: v, inputs: inputs, tag: tag, errs: url.Values{}}
+	rv := reflect.ValueOf(data).Elem()
+	if rv.Kind() == reflect.Struct {
+		d.decodeStruct(rv, "")
+	}
+	return d.errs
+}
+
+// decodeStruct decode the form values into the fields of the struct
+// it returns if any value is found for the struct
+func (d *formDecoder) decodeStruct(rv reflect.Value, prefix string) bool {
+	found := false
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		fv := rv.Field(i)
+		name := sf.Name
+		if tag := sf.Tag.Get(d.tag); tag != "" {
+			name = strings.Split(strings.Split(tag, tagSeparator)[0], ",")[0]
+			if name == "-" {
+				continue
+			}
+		}
+		if sf.Anonymous && sf.Tag.Get(d.tag) == "" && fv.Kind() == reflect.Struct {
+			found = d.decodeStruct(fv, prefix) || found
+			continue
+		}
+		if sf.PkgPath != "" || !fv.CanSet() {
+			continue // unexported field
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		key := joinPath(prefix, name)
+
+		ft := sf.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		switch {
+		case ft.Kind() == reflect.Struct && !isFormScalar(ft):
+			// nil pointer is allocated only if any value is found for the struct
+			elem := reflect.New(ft)
+			if fv.Kind() != reflect.Ptr || !fv.IsNil() {
+				elem.Elem().Set(reflect.Indirect(fv))
+			}
+			if d.decodeStruct(elem.Elem(), key) {
+				found = true
+				if fv.Kind() == reflect.Ptr {
+					fv.Set(elem)
+				} else {
+					fv.Set(elem.Elem())
+				}
+			}
+		case ft.Kind() == reflect.Slice && ft.Elem().Kind() == reflect.Struct && !isFormScalar(ft.Elem()):
+			children := formChildSegments(d.inputs, key)
+			slice := reflect.MakeSlice(ft, 0, len(children))
+			for _, child := range children {
+				if _, err := strconv.Atoi(child); err != nil {
+					continue
+				}
+				elem := reflect.New(ft.Elem()).Elem()
+				d.decodeStruct(elem, key+"."+child)
+				slice = reflect.Append(slice, elem)
+			}
+			if slice.Len() > 0 {
+				found = true
+				allocPtr(fv).Set(slice)
+			}
+		default:
+			vals, _ := formValues(d.inputs, key)
+			if vals == nil && prefix != "" {
+				vals, _ = formValues(d.inputs, name)
+			}
+			if vals == nil {
+				continue
+			}
+			found = true
+			if err := setFormValue(fv, vals); err != nil {
+				// the error is keyed like the decode errors of JSON body, see ruleKey
+				field := d.v.ruleKey(key)
+				msg := d.v.getCustomMessage(field, "type")
+				if msg == "" {
+					if d.loc == nil {
+						d.loc = d.v.newLocalizer()
+					}
+					msg = d.loc.message("type."+err.Error(), key, "type", err.Error())
+				}
+				d.errs.Add(field, msg)
+			}
+		}
+	}
+	return found
+}
+
+// allocPtr allocate the nil pointer and return the element
+func allocPtr(fv reflect.Value) reflect.Value {
+	if fv.Kind() != reflect.Ptr {
+		return fv
+	}
+	if fv.IsNil() {
+		fv.Set(reflect.New(fv.Type().Elem()))
+	}
+	return fv.Elem()
+}
+
+// isFormScalar check if the struct type is decoded from a single form value
+// e.g: time.Time, govalidator.Int or a type implementing encoding.TextUnmarshaler
+func isFormScalar(t reflect.Type) bool {
+	return t == timeType || isJSONUnmarshaler(t)
+}
+
+// formScalarKey return the expected type name of a type decoding itself
+// wrappers like govalidator.Int are named by the type of their value
+func formScalarKey(t reflect.Type) string {
+	if n, ok := reflect.Zero(t).Interface().(nullable); ok {
+		if val, _ := n.nullableValue(); val != nil {
+			return jsonTypeKey(reflect.TypeOf(val))
+		}
+	}
+	return t.Name()
+}
+
+// setFormValue convert the form values into the type of the field
+// the returned error is the expected type name used as the suffix of the type message key e.g: integer
+func setFormValue(fv reflect.Value, vals []string) error {
+	if fv.Kind() == reflect.Ptr {
+		if len(vals) == 0 || strings.TrimSpace(vals[0]) == "" {
+			return nil
+		}
+		return setFormValue(allocPtr(fv), vals)
+	}
+
+	if fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() != reflect.Uint8 && !isFormScalar(fv.Type()) {
+		slice := reflect.MakeSlice(fv.Type(), len(vals), len(vals))
+		for i, val := range vals {
+			if err := setFormValue(slice.Index(i), []string{val}); err != nil {
+				return err
+			}
+		}
+		fv.Set(slice)
+		return nil
+	}
+
+	val := ""
+	if len(vals) > 0 {
+		val = strings.TrimSpace(vals[0])
+	}
+	if val == "" && fv.Kind() != reflect.String {
+		return nil
+	}
+
+	if fv.Type() == timeType {
+		t, ok := parseTime(val)
+		if !ok {
+			return errors.New("date")
+		}
+		fv.Set(reflect.ValueOf(t))
+		return nil
+	}
+	if fv.CanAddr() {
+		switch u := fv.Addr().Interface().(type) {
+		case encoding.TextUnmarshaler:
+			if err := u.UnmarshalText([]byte(val)); err != nil {
+				return errors.New(formScalarKey(fv.Type()))
+			}
+			return nil
+		case json.Unmarshaler:
+			// wrappers like govalidator.Int decode the raw JSON value, string is quoted as fallback
+			if err := u.UnmarshalJSON([]byte(val)); err != nil {
+				quoted, _ := json.Marshal(val)
+				if err := u.UnmarshalJSON(quoted); err != nil {
+					return errors.New(formScalarKey(fv.Type()))
+				}
+			}
+			return nil
+		}
+	}
+
+	typeErr := errors.New(jsonTypeKey(fv.Type()))
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(val)
+	case reflect.Bool:
+		switch strings.ToLower(val) {
+		case "on":
+			fv.SetBool(true)
+		case "off":
+			fv.SetBool(false)
+		default:
+			b, err := strconv.ParseBool(val)
+			if err != nil {
+				return typeErr
+			}
+			fv.SetBool(b)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(val, 10, fv.Type().Bits())
+		if err != nil {
+			return typeErr
+		}
+		fv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(val, 10, fv.Type().Bits())
+		if err != nil {
+			return typeErr
+		}
+		fv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(val, fv.Type().Bits())
+		if err != nil {
+			return typeErr
+		}
+		fv.SetFloat(f)
+	case reflect.Interface:
+		if fv.NumMethod() == 0 {
+			fv.Set(reflect.ValueOf(val))
+		}
+	}
+	return nil
+}
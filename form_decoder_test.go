@@ -0,0 +1,171 @@
+package govalidator
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+type formAddress struct {
+	City string `json:"city"`
+	Zip  int    `json:"zip"`
+}
+
+type formUser struct {
+	Name     string       `json:"name"`
+	Age      int          `json:"age"`
+	Score    float64      `json:"score"`
+	Active   bool         `json:"active"`
+	Tags     []string     `json:"tags"`
+	Ids      []int        `json:"ids"`
+	Birthday time.Time    `json:"birthday"`
+	Count    Int          `json:"count"`
+	Address  formAddress  `json:"address"`
+	Billing  *formAddress `json:"billing"`
+}
+
+func newFormRequest(body string) *http.Request {
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func TestValidator_ValidateForm(t *testing.T) {
+	body := "name=John&age=30&score=4.5&active=on&tags[]=go&tags[]=api&ids[0]=1&ids[1]=2" +
+		"&birthday=1990-01-02&count=5&address[city]=Dhaka&address[zip]=1200"
+	user := formUser{}
+	opts := Options{
+		Request: newFormRequest(body),
+		Data:    &user,
+		Rules: MapData{
+			"name": []string{"required"},
+			"age":  []string{"numeric_between:18,60"},
+			"city": []string{"required"},
+		},
+	}
+
+	validationErr := New(opts).ValidateForm()
+	if len(validationErr) != 0 {
+		t.Log(validationErr)
+		t.Error("ValidateForm failed")
+	}
+	if user.Name != "John" || user.Age != 30 || user.Score != 4.5 || !user.Active {
+		t.Errorf("ValidateForm failed to decode scalar values: %+v", user)
+	}
+	if len(user.Tags) != 2 || user.Tags[1] != "api" || len(user.Ids) != 2 || user.Ids[1] != 2 {
+		t.Errorf("ValidateForm failed to decode slices: %v %v", user.Tags, user.Ids)
+	}
+	if !user.Birthday.Equal(time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ValidateForm failed to decode time: %v", user.Birthday)
+	}
+	if !user.Count.IsSet || user.Count.V != 5 {
+		t.Errorf("ValidateForm failed to decode govalidator.Int: %+v", user.Count)
+	}
+	if user.Address.City != "Dhaka" || user.Address.Zip != 1200 {
+		t.Errorf("ValidateForm failed to decode nested struct: %+v", user.Address)
+	}
+	if user.Billing != nil {
+		t.Error("ValidateForm allocated nested pointer struct without any value")
+	}
+}
+
+func TestValidator_ValidateForm_FlatNestedKeys(t *testing.T) {
+	user := formUser{}
+	req, _ := http.NewRequest("GET", "http://www.example.com?city=Dhaka&zip=1200", nil)
+	opts := Options{
+		Request: req,
+		Data:    &user,
+		Rules: MapData{
+			"city": []string{"required"},
+		},
+	}
+
+	validationErr := New(opts).ValidateForm()
+	if len(validationErr) != 0 {
+		t.Log(validationErr)
+		t.Error("ValidateForm failed")
+	}
+	if user.Address.City != "Dhaka" || user.Billing == nil || user.Billing.Zip != 1200 {
+		t.Errorf("ValidateForm failed to decode flat keys into nested struct: %+v %+v", user.Address, user.Billing)
+	}
+}
+
+func TestValidator_ValidateForm_ConversionErrors(t *testing.T) {
+	user := formUser{}
+	opts := Options{
+		Request: newFormRequest("age=abc&active=maybe&ids[]=1&ids[]=x&birthday=yesterday&count=five&name=J"),
+		Data:    &user,
+		Rules: MapData{
+			"name": []string{"min:3"},
+			"age":  []string{"required"},
+		},
+		Messages: MapData{
+			"active": []string{"type:Active must be yes or no"},
+		},
+	}
+
+	validationErr := New(opts).ValidateForm()
+	expected := map[string]string{
+		"name":     "The name field must be minimum 3 char",
+		"age":      "The age field must be an integer",
+		"active":   "Active must be yes or no",
+		"ids":      "The ids field must be an integer",
+		"birthday": "The birthday field must be a valid date",
+		"count":    "The count field must be an integer",
+	}
+	if len(validationErr) != len(expected) {
+		t.Log(validationErr)
+		t.Error("ValidateForm failed to report conversion errors")
+	}
+	for field, msg := range expected {
+		if validationErr.Get(field) != msg {
+			t.Errorf("expected %q for %s, got %q", msg, field, validationErr.Get(field))
+		}
+	}
+}
+
+func TestValidator_ValidateForm_NonPointer_panic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("ValidateForm did not panic")
+		}
+	}()
+
+	opts := Options{
+		Request: newFormRequest("name=John"),
+		Data:    formUser{},
+		Rules: MapData{
+			"name": []string{"required"},
+		},
+	}
+	New(opts).ValidateForm()
+}
+
+func TestValidator_ValidateForm_NestedConversionError(t *testing.T) {
+	user := formUser{}
+	opts := Options{
+		Request: newFormRequest("address[city]=Dhaka&address[zip]=abc&billing[zip]=x"),
+		Data:    &user,
+		Rules: MapData{
+			"zip":         []string{"required", "digits:4"},
+			"billing.zip": []string{"required"},
+		},
+	}
+
+	validationErr := New(opts).ValidateForm()
+	if len(validationErr["zip"]) != 1 || validationErr.Get("zip") != "The address.zip field must be an integer" {
+		t.Log(validationErr)
+		t.Error("ValidateForm failed to report nested conversion error by the rule key")
+	}
+	if validationErr.Get("billing.zip") == "" {
+		t.Log(validationErr)
+		t.Error("ValidateForm failed to report conversion error by the dotted rule key")
+	}
+}
+
+func TestValidator_ValidateForm_NilData_panic(t *testing.T) {
+	assertPanicWith(t, errRequireData, func() {
+		New(Options{Request: newFormRequest("name=John"), Rules: MapData{"name": []string{"required"}}}).ValidateForm()
+	})
+}
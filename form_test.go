@@ -0,0 +1,111 @@
+package govalidator
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func Test_bracketToDotted(t *testing.T) {
+	keys := map[string]string{
+		"name":                 "name",
+		"user[address][city]":  "user.address.city",
+		"items[0][sku]":        "items.0.sku",
+		"tags[]":               "tags[]",
+		"user[tags][]":         "user.tags[]",
+		"user[][city]":         "user[][city]",
+		"user[address":         "user[address",
+		"[address]":            "[address]",
+		"user[address]x[city]": "user[address]x[city]",
+	}
+	for k, expected := range keys {
+		if got := bracketToDotted(k); got != expected {
+			t.Errorf("bracketToDotted failed for %s, expected %s got %s", k, expected, got)
+		}
+	}
+}
+
+func Test_wildcardKey(t *testing.T) {
+	if wildcardKey("items.0.sku") != "items.*.sku" {
+		t.Error("wildcardKey failed")
+	}
+	if wildcardKey("0.sku") != "0.sku" {
+		t.Error("wildcardKey failed to keep the first segment")
+	}
+}
+
+func TestValidator_Validate_BracketNotation(t *testing.T) {
+	form := url.Values{}
+	form.Add("user[address][city]", "Dhaka")
+	form.Add("user[address][zip]", "12")
+	form.Add("items[0][sku]", "X-1")
+	form.Add("items[0][qty]", "2")
+	form.Add("items[1][qty]", "abc")
+	form.Add("items[10][sku]", "X-10")
+	form.Add("colors[0]", "red")
+	form.Add("colors[1]", "#fff")
+
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	opts := Options{
+		Request: req,
+		Rules: MapData{
+			"user.address.city": []string{"required", "alpha"},
+			"user.address.zip":  []string{"digits:4"},
+			"items.*.sku":       []string{"required"},
+			"items.*.qty":       []string{"numeric"},
+			"colors":            []string{"min:2", "each:alpha"},
+		},
+		Messages: MapData{
+			"items.*.sku": []string{"required:The sku of every item is required"},
+		},
+	}
+
+	validationErr := New(opts).Validate()
+	if validationErr.Get("user.address.zip") == "" {
+		t.Error("Validate failed to validate nested form field")
+	}
+	if validationErr.Get("items.1.sku") != "The sku of every item is required" {
+		t.Error("Validate failed to validate wildcard form field")
+	}
+	if validationErr.Get("items.1.qty") == "" || validationErr.Get("items.10.qty") != "" {
+		t.Error("Validate failed to validate optional wildcard form field")
+	}
+	if validationErr.Get("colors.1") == "" {
+		t.Error("Validate failed to validate indexed form field")
+	}
+	if len(validationErr) != 4 {
+		t.Log(validationErr)
+		t.Error("Validate reported unexpected errors")
+	}
+}
+
+func TestValidator_ValidateValues_LegacyBracketRuleKey(t *testing.T) {
+	opts := Options{
+		Rules:    MapData{"user[name]": []string{"required", "alpha"}},
+		Messages: MapData{"user[name]": []string{"alpha:The name must be letters"}},
+	}
+	if errs := New(opts).ValidateValues(url.Values{"user[name]": []string{"john"}}); len(errs) != 0 {
+		t.Errorf("ValidateValues failed to match the bracket rule key: %v", errs)
+	}
+	errs := New(opts).ValidateValues(url.Values{"user[name]": []string{"john1"}})
+	if errs.Get("user[name]") != "The name must be letters" {
+		t.Errorf("ValidateValues failed to report the bracket rule key: %v", errs)
+	}
+}
+
+func TestValidator_ValidateValues_RequiredWildcardWithoutMatch(t *testing.T) {
+	opts := Options{
+		Rules: MapData{
+			"items.*.sku": []string{"required"},
+			"items.*.qty": []string{"numeric"},
+		},
+	}
+	validationErr := New(opts).ValidateValues(url.Values{"name": []string{"John"}})
+	if len(validationErr) != 1 || validationErr.Get("items.*.sku") == "" {
+		t.Log(validationErr)
+		t.Error("ValidateValues failed to report required wildcard key without any item")
+	}
+}
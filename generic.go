@@ -0,0 +1,42 @@
+package govalidator
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+)
+
+// ValidationErrors represents the validation errors keyed by the field
+type ValidationErrors = url.Values
+
+// DecodeJSON decode the JSON body of the request into a new value of T and validate it against the rules
+// T can be a struct, a map or a pointer to them, the pointer is allocated
+// the missing request is reported as error instead of panic
+func DecodeJSON[T any](r *http.Request, rules MapData) (T, ValidationErrors) {
+	var data T
+	if r == nil {
+		return data, ValidationErrors{errorKey: []string{errValidateArgsMismatch.Error()}}
+	}
+	v := New(Options{Request: r, Data: typedPtr(&data), Rules: rules})
+	decode, _ := v.getDecoder("application/json")
+	return data, v.internalValidateStruct(decode, tagIdentifier)
+}
+
+// ValidateValue validate the struct or map value against the rules
+// a nil pointer is validated as the zero value of the element type
+func ValidateValue[T any](value T, rules MapData) ValidationErrors {
+	v := New(Options{Data: typedPtr(&value), Rules: rules})
+	return v.internalValidateStruct(nil, tagIdentifier)
+}
+
+// typedPtr return the pointer to pass as data, the value itself if it is a pointer allocating it if nil
+func typedPtr[T any](data *T) interface{} {
+	rv := reflect.ValueOf(data).Elem()
+	if rv.Kind() != reflect.Ptr {
+		return data
+	}
+	if rv.IsNil() {
+		rv.Set(reflect.New(rv.Type().Elem()))
+	}
+	return rv.Interface()
+}
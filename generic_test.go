@@ -0,0 +1,59 @@
+package govalidator
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type genericUser struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func TestDecodeJSON(t *testing.T) {
+	rules := MapData{"name": []string{"required"}, "age": []string{"min:18"}}
+	req := func(body string) *http.Request {
+		r, _ := http.NewRequest("POST", "/", strings.NewReader(body))
+		return r
+	}
+
+	u, errs := DecodeJSON[genericUser](req(`{"name":"john","age":20}`), rules)
+	if len(errs) != 0 || u.Name != "john" || u.Age != 20 {
+		t.Errorf("DecodeJSON failed to decode the struct: %v %+v", errs, u)
+	}
+
+	p, errs := DecodeJSON[*genericUser](req(`{"age":10}`), rules)
+	if len(errs) != 2 || p == nil || p.Age != 10 {
+		t.Errorf("DecodeJSON failed to decode the pointer: %v %+v", errs, p)
+	}
+
+	m, errs := DecodeJSON[map[string]interface{}](req(`{"name":"jane"}`), MapData{"name": []string{"max:3"}})
+	if len(errs) != 1 || m["name"] != "jane" {
+		t.Errorf("DecodeJSON failed to decode the map: %v %v", errs, m)
+	}
+
+	if _, errs = DecodeJSON[genericUser](req(`{"name":`), nil); errs.Get("_error") == "" {
+		t.Error("DecodeJSON failed to report the syntax error without rules")
+	}
+	if _, errs = DecodeJSON[genericUser](nil, rules); errs.Get("_error") == "" {
+		t.Error("DecodeJSON failed to report the missing request")
+	}
+}
+
+func TestValidateValue(t *testing.T) {
+	rules := MapData{"name": []string{"required"}, "age": []string{"min:18"}}
+	if errs := ValidateValue(genericUser{Name: "john", Age: 20}, rules); len(errs) != 0 {
+		t.Errorf("ValidateValue failed to validate the struct: %v", errs)
+	}
+	if errs := ValidateValue(&genericUser{Age: 10}, rules); len(errs) != 2 {
+		t.Errorf("ValidateValue failed to validate the pointer: %v", errs)
+	}
+	var nilUser *genericUser
+	if errs := ValidateValue(nilUser, rules); errs.Get("name") == "" {
+		t.Errorf("ValidateValue failed to validate the nil pointer: %v", errs)
+	}
+	if errs := ValidateValue(map[string]interface{}{"age": 10}, rules); len(errs) != 2 {
+		t.Errorf("ValidateValue failed to validate the map: %v", errs)
+	}
+}
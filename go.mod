@@ -0,0 +1,3 @@
+module github.com/thedevsaddam/govalidator
+
+go 1.22
@@ -37,8 +37,8 @@ func isBoolean(str string) bool {
 	return false
 }
 
-//isCreditCard check the provided card number is a valid
-//  Visa, MasterCard, American Express, Diners Club, Discover or JCB card
+// isCreditCard check the provided card number is a valid
+// Visa, MasterCard, American Express, Diners Club, Discover or JCB card
 func isCreditCard(card string) bool {
 	return regexCreditCard.MatchString(card)
 }
@@ -0,0 +1,366 @@
+package govalidator
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var errTrailingData = errors.New("unexpected data after top-level JSON value")
+
+// fieldErrors represents decode errors keyed by field, the errors are merged into the error bag
+// and the rules are validated against the decoded data
+type fieldErrors url.Values
+
+// Error return the errors as a single string
+func (fe fieldErrors) Error() string {
+	msgs := make([]string, 0, len(fe))
+	for field, errs := range fe {
+		msgs = append(msgs, field+": "+strings.Join(errs, ", "))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// isJSONMediaType check if the media type body is decoded as JSON
+func isJSONMediaType(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// jsonField represents a struct field of JSON body
+type jsonField struct {
+	typ      reflect.Type
+	asString bool // asString represents the ",string" tag option
+}
+
+// jsonWalker walk through the JSON tokens checking the values against the type of the data
+// type mismatch and invalid UTF-8 are reported per field keyed by the JSON path
+// in strict mode unknown fields and duplicate keys are reported too
+type jsonWalker struct {
+	v      *Validator
+	dec    *json.Decoder
+	body   []byte
+	strict bool
+	errs   url.Values
+	loc    *localizer // loc is built on the first error
+}
+
+// decodeJSON decode the JSON body into data reporting the decode errors per field
+// custom messages can be provided using the type, utf8, unknown, duplicate and syntax (for _error) rule names
+func (v *Validator) decodeJSON(body io.Reader, data interface{}) error {
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	w := &jsonWalker{
+		v:      v,
+		dec:    json.NewDecoder(bytes.NewReader(b)),
+		body:   b,
+		strict: v.Opts.Strict,
+		errs:   url.Values{},
+	}
+	w.dec.UseNumber()
+	if err := w.walk(reflect.TypeOf(data), "", false); err != nil {
+		return w.syntaxError(err)
+	}
+	if _, err := w.dec.Token(); w.strict && err != io.EOF {
+		return errTrailingData
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	if v.Opts.UseNumber {
+		dec.UseNumber()
+	}
+	err = dec.Decode(data)
+	if ute, ok := err.(*json.UnmarshalTypeError); ok {
+		// the decoder keep going after a type mismatch, the rest of the fields are decoded
+		if _, ok := w.errs[w.v.ruleKey(ute.Field)]; !ok && ute.Field != "" {
+			w.addTypeError(ute.Field, ute.Type)
+		}
+	} else if err != nil {
+		return err
+	}
+	if len(w.errs) > 0 {
+		return fieldErrors(w.errs)
+	}
+	return nil
+}
+
+// addError add the custom message of the rule if exist otherwise the message of the key from the selected locale
+// the error is keyed by the rule key of the JSON path, see ruleKey
+func (w *jsonWalker) addError(path, rule, key string, params ...string) {
+	field := w.v.ruleKey(path)
+	msg := w.v.getCustomMessage(field, rule)
+	if msg == "" {
+		msg = w.localizer().message(key, path, params...)
+	}
+	w.errs.Add(field, msg)
+}
+
+// addTypeError add the type mismatch error of the path
+func (w *jsonWalker) addTypeError(path string, t reflect.Type) {
+	typ := jsonTypeKey(t)
+	w.addError(path, "type", "type."+typ, "type", typ)
+}
+
+// localizer return the localizer of the validator building it on the first call
+func (w *jsonWalker) localizer() *localizer {
+	if w.loc == nil {
+		w.loc = w.v.newLocalizer()
+	}
+	return w.loc
+}
+
+// syntaxError add the line and column to the syntax error of the body
+func (w *jsonWalker) syntaxError(err error) error {
+	se, ok := err.(*json.SyntaxError)
+	if !ok {
+		return err
+	}
+	if msg := w.v.getCustomMessage("_error", "syntax"); msg != "" {
+		return errors.New(msg)
+	}
+	// the offset is after reading the invalid character
+	offset := se.Offset - 1
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > int64(len(w.body)) {
+		offset = int64(len(w.body))
+	}
+	line, col := 1, 1
+	for _, c := range w.body[:offset] {
+		if c == '\n' {
+			line++
+			col = 1
+			continue
+		}
+		col++
+	}
+	tmpl, _ := w.localizer().templates("syntax")
+	return errors.New(renderMessage(tmpl, "", nil, "error", se.Error(), "line", strconv.Itoa(line), "column", strconv.Itoa(col)))
+}
+
+// walk through the next JSON value checking it against the type
+// nil type accept any value e.g: interface{} or a type implementing json.Unmarshaler
+// mismatched values are walked through without checking to find the rest of the errors
+func (w *jsonWalker) walk(t reflect.Type, path string, asString bool) error {
+	t = jsonTargetType(t)
+	start := w.dec.InputOffset()
+	tok, err := w.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		if _, ok := tok.(string); ok && !utf8.Valid(w.body[start:w.dec.InputOffset()]) {
+			w.addError(path, "utf8", "utf8")
+		}
+		if t != nil && !asString && !isJSONValueOf(tok, t) {
+			w.addTypeError(path, t)
+		}
+		return nil
+	}
+
+	switch delim {
+	case '{':
+		if t != nil && t.Kind() != reflect.Struct && t.Kind() != reflect.Map {
+			w.addTypeError(path, t)
+			t = nil
+		}
+		var fields map[string]jsonField
+		if t != nil && t.Kind() == reflect.Struct {
+			fields = jsonFields(t)
+		}
+		seen := make(map[string]struct{})
+		for w.dec.More() {
+			tok, err := w.dec.Token()
+			if err != nil {
+				return err
+			}
+			key := tok.(string)
+			field := joinPath(path, key)
+			if _, ok := seen[key]; ok && w.strict {
+				w.addError(field, "duplicate", "duplicate")
+			}
+			seen[key] = struct{}{}
+
+			var child jsonField
+			if t != nil {
+				switch t.Kind() {
+				case reflect.Struct:
+					f, ok := lookupJSONField(fields, key)
+					if !ok && w.strict {
+						w.addError(field, "unknown", "unknown")
+					}
+					child = f
+				case reflect.Map:
+					child.typ = t.Elem()
+				}
+			}
+			if err := w.walk(child.typ, field, child.asString); err != nil {
+				return err
+			}
+		}
+	case '[':
+		if t != nil && t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			w.addTypeError(path, t)
+			t = nil
+		}
+		var child reflect.Type
+		if t != nil {
+			child = t.Elem()
+		}
+		for i := 0; w.dec.More(); i++ {
+			if err := w.walk(child, joinPath(path, strconv.Itoa(i)), false); err != nil {
+				return err
+			}
+		}
+	}
+
+	// consume the closing delimiter
+	_, err = w.dec.Token()
+	return err
+}
+
+// joinPath join the JSON path and the key using dot notation
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// isJSONValueOf check if the scalar JSON token can be decoded into the type
+func isJSONValueOf(tok json.Token, t reflect.Type) bool {
+	switch v := tok.(type) {
+	case nil:
+		return true
+	case bool:
+		return t.Kind() == reflect.Bool
+	case string:
+		return t.Kind() == reflect.String || (t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8)
+	case json.Number:
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			_, err := strconv.ParseInt(v.String(), 10, t.Bits())
+			return err == nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			_, err := strconv.ParseUint(v.String(), 10, t.Bits())
+			return err == nil
+		case reflect.Float32, reflect.Float64:
+			_, err := strconv.ParseFloat(v.String(), t.Bits())
+			return err == nil
+		}
+		return false
+	}
+	return true
+}
+
+// jsonTypeKey return the JSON type name of the Go type used as the suffix of the type message key
+// e.g: integer for type.integer, the name of the Go type is returned for the other types
+func jsonTypeKey(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Struct, reflect.Map:
+		return "object"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	}
+	return t.String()
+}
+
+// jsonTargetType dereference the pointer types and return nil for the types which accept any value
+func jsonTargetType(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Ptr {
+		if isJSONUnmarshaler(t) {
+			return nil
+		}
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() == reflect.Interface || isJSONUnmarshaler(t) {
+		return nil
+	}
+	return t
+}
+
+// isJSONUnmarshaler check if the type or pointer to the type decode itself
+func isJSONUnmarshaler(t reflect.Type) bool {
+	jsonUnmarshaler := reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshaler := reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	if t.Implements(jsonUnmarshaler) || t.Implements(textUnmarshaler) {
+		return true
+	}
+	if t.Kind() != reflect.Ptr {
+		pt := reflect.PtrTo(t)
+		return pt.Implements(jsonUnmarshaler) || pt.Implements(textUnmarshaler)
+	}
+	return false
+}
+
+// jsonFields return the JSON names of the struct fields including the promoted fields of embedded structs
+func jsonFields(t reflect.Type) map[string]jsonField {
+	fields := make(map[string]jsonField)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		opts := strings.Split(tag, ",")
+		name := opts[0]
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for k, v := range jsonFields(ft) {
+					if _, ok := fields[k]; !ok {
+						fields[k] = v
+					}
+				}
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue // unexported field
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = jsonField{typ: f.Type, asString: isIn(opts[1:], "string")}
+	}
+	return fields
+}
+
+// lookupJSONField find the field by the key, the exact match is preferred over case insensitive match
+// like encoding/json does
+func lookupJSONField(fields map[string]jsonField, key string) (jsonField, bool) {
+	if f, ok := fields[key]; ok {
+		return f, true
+	}
+	for name, f := range fields {
+		if strings.EqualFold(name, key) {
+			return f, true
+		}
+	}
+	return jsonField{}, false
+}
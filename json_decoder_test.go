@@ -0,0 +1,190 @@
+package govalidator
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type strictAddress struct {
+	City string `json:"city"`
+}
+
+type strictUser struct {
+	Name    string            `json:"name"`
+	Email   string            `json:"email"`
+	Age     Int               `json:"age"`
+	Meta    map[string]string `json:"meta"`
+	Address strictAddress     `json:"address"`
+}
+
+func TestValidator_ValidateJSON_Strict(t *testing.T) {
+	body := `{"name":"John","emial":"john@mail.com","age":20,"meta":{"any":"key"},"address":{"city":"Dhaka","zipp":"1207"},"name":"Jane"}`
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader(body))
+
+	var user strictUser
+	opts := Options{
+		Request: req,
+		Data:    &user,
+		Rules: MapData{
+			"email": []string{"required"},
+		},
+		Strict: true,
+	}
+
+	validationErr := New(opts).ValidateJSON()
+	if validationErr.Get("emial") != "unknown field" {
+		t.Error("Strict mode failed to report unknown field")
+	}
+	if validationErr.Get("address.zipp") != "unknown field" {
+		t.Error("Strict mode failed to report nested unknown field")
+	}
+	if validationErr.Get("name") != "duplicate field" {
+		t.Error("Strict mode failed to report duplicate field")
+	}
+	if validationErr.Get("email") == "" {
+		t.Error("Strict mode failed to validate rules")
+	}
+	if len(validationErr) != 4 {
+		t.Log(validationErr)
+		t.Error("Strict mode reported unexpected errors")
+	}
+}
+
+func TestValidator_ValidateJSON_Strict_TrailingData(t *testing.T) {
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader(`{"name":"John"} {"name":"Jane"}`))
+
+	var user strictUser
+	opts := Options{
+		Request: req,
+		Data:    &user,
+		Rules: MapData{
+			"name": []string{"required"},
+		},
+		Strict: true,
+	}
+
+	validationErr := New(opts).ValidateJSON()
+	if validationErr.Get("_error") != errTrailingData.Error() {
+		t.Log(validationErr)
+		t.Error("Strict mode failed to reject trailing data")
+	}
+}
+
+func TestValidator_decodeJSON_Map(t *testing.T) {
+	data := map[string]interface{}{}
+	v := New(Options{Strict: true})
+	err := v.decodeJSON(strings.NewReader(`{"a":1,"b":{"a":2},"a":3}`), &data)
+	fe, ok := err.(fieldErrors)
+	if !ok || len(fe) != 1 || fe["a"][0] != "duplicate field" {
+		t.Error("decodeJSON failed to report duplicate key in map")
+	}
+}
+
+func TestValidator_ValidateJSON_TypeMismatch(t *testing.T) {
+	type Address struct {
+		City string `json:"city"`
+		Zip  int    `json:"zip"`
+	}
+	type User struct {
+		Name    string   `json:"name"`
+		Age     int      `json:"age"`
+		Active  bool     `json:"active"`
+		Tags    []string `json:"tags"`
+		Address Address  `json:"address"`
+	}
+
+	body := `{"name":"J","age":"twenty","active":1,"tags":["a",2],"address":{"city":"Dhaka","zip":12.5}}`
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader(body))
+
+	var user User
+	opts := Options{
+		Request: req,
+		Data:    &user,
+		Rules: MapData{
+			"name": []string{"between:3,8"},
+			"city": []string{"len:4"},
+			"age":  []string{"required", "numeric_between:18,60"},
+			"zip":  []string{"required"},
+		},
+		Messages: MapData{
+			"age": []string{"type:Age must be a number"},
+		},
+	}
+
+	validationErr := New(opts).ValidateJSON()
+	if validationErr.Get("age") != "Age must be a number" {
+		t.Error("ValidateJSON failed to use custom message for type mismatch")
+	}
+	if validationErr.Get("active") != "The active field must be a boolean" {
+		t.Error("ValidateJSON failed to report type mismatch")
+	}
+	if len(validationErr["age"]) != 1 {
+		t.Error("ValidateJSON validated the rules of the field failed to decode")
+	}
+	if validationErr.Get("tags.1") == "" || validationErr.Get("zip") != "The address.zip field must be an integer" {
+		t.Error("ValidateJSON failed to report nested type mismatch by the rule key")
+	}
+	if validationErr.Get("name") == "" || validationErr.Get("city") == "" {
+		t.Error("ValidateJSON failed to continue rule validation after type mismatch")
+	}
+	if user.Address.City != "Dhaka" {
+		t.Error("ValidateJSON failed to decode the valid fields")
+	}
+	if len(validationErr) != 6 {
+		t.Log(validationErr)
+		t.Error("ValidateJSON reported unexpected errors")
+	}
+}
+
+func TestValidator_ValidateJSON_SyntaxError(t *testing.T) {
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader("{\n\"name\": \"John\",\n\"age\": 2x\n}"))
+
+	data := map[string]interface{}{}
+	opts := Options{
+		Request: req,
+		Data:    &data,
+		Rules: MapData{
+			"name": []string{"required"},
+		},
+	}
+
+	validationErr := New(opts).ValidateJSON()
+	if !strings.HasSuffix(validationErr.Get("_error"), "at line 3, column 9") {
+		t.Log(validationErr)
+		t.Error("ValidateJSON failed to report line and column of syntax error")
+	}
+}
+
+func TestValidator_ValidateJSON_InvalidUTF8(t *testing.T) {
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader("{\"name\":\"Jo\xffhn\"}"))
+
+	data := map[string]interface{}{}
+	opts := Options{
+		Request: req,
+		Data:    &data,
+		Rules: MapData{
+			"name": []string{"required"},
+		},
+	}
+
+	validationErr := New(opts).ValidateJSON()
+	if validationErr.Get("name") != "The name field must be a valid UTF-8 string" {
+		t.Log(validationErr)
+		t.Error("ValidateJSON failed to report invalid UTF-8")
+	}
+}
+
+func TestValidator_getDecoder_Registered(t *testing.T) {
+	defer delete(decodersFuncMap, "application/vnd.x+json")
+	AddDecoder("application/vnd.x+json", decodeXML)
+
+	v := New(Options{})
+	if fn, _ := v.getDecoder("application/vnd.x+json"); reflect.ValueOf(fn).Pointer() != reflect.ValueOf(decodeXML).Pointer() {
+		t.Error("getDecoder ignored the registered decoder of JSON media type")
+	}
+	if _, ok := v.getDecoder("application/problem+json"); !ok {
+		t.Error("getDecoder failed to fall back to the JSON decoder")
+	}
+}
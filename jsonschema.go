@@ -0,0 +1,399 @@
+package govalidator
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// JSONSchema represents a JSON Schema / OpenAPI schema object
+// the rules having no equivalent keyword are kept in Extensions using x- prefixed keys e.g: x-alpha
+type JSONSchema struct {
+	Type             string                 `json:"type,omitempty"`
+	Format           string                 `json:"format,omitempty"`
+	Pattern          string                 `json:"pattern,omitempty"`
+	Enum             []interface{}          `json:"enum,omitempty"`
+	Not              *JSONSchema            `json:"not,omitempty"`
+	MinLength        *int                   `json:"minLength,omitempty"`
+	MaxLength        *int                   `json:"maxLength,omitempty"`
+	Minimum          *float64               `json:"minimum,omitempty"`
+	Maximum          *float64               `json:"maximum,omitempty"`
+	MinItems         *int                   `json:"minItems,omitempty"`
+	MaxItems         *int                   `json:"maxItems,omitempty"`
+	ContentMediaType string                 `json:"contentMediaType,omitempty"`
+	Items            *JSONSchema            `json:"items,omitempty"`
+	Properties       map[string]*JSONSchema `json:"properties,omitempty"`
+	Required         []string               `json:"required,omitempty"`
+	Extensions       map[string]interface{} `json:"-"`
+}
+
+// MarshalJSON encode the schema merging the extensions into the object
+func (s JSONSchema) MarshalJSON() ([]byte, error) {
+	type schema JSONSchema
+	b, err := json.Marshal(schema(s))
+	if err != nil || len(s.Extensions) == 0 {
+		return b, err
+	}
+	ext, err := json.Marshal(s.Extensions)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 2 {
+		return ext, nil
+	}
+	return append(append(b[:len(b)-1], ','), ext[1:]...), nil
+}
+
+var (
+	nullableType  = reflect.TypeOf((*nullable)(nil)).Elem()
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	valuerType    = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	vValuerType   = reflect.TypeOf((*ValidationValuer)(nil)).Elem()
+	numberType    = reflect.TypeOf(json.Number(""))
+)
+
+// schemaFormats represents the rules mapped to the format keyword
+var schemaFormats = map[string]string{
+	"email":   "email",
+	"uuid":    "uuid",
+	"uuid_v3": "uuid",
+	"uuid_v4": "uuid",
+	"uuid_v5": "uuid",
+	"date":    "date",
+	"ip_v4":   "ipv4",
+	"ip_v6":   "ipv6",
+	"url":     "uri",
+}
+
+// ToJSONSchema return the JSON Schema of the rules, the property types are taken from the struct type of the sample
+// e.g: ToJSONSchema(rules, User{}), sample can be nil to build the schema from the rules only
+// the dotted keys become nested properties and the wildcard keys become items e.g: items.*.sku
+// a key without dot is looked up in the nested structs of the sample like the validation does
+func ToJSONSchema(rules MapData, sample interface{}) *JSONSchema {
+	root := &JSONSchema{}
+	if sample != nil {
+		root = typeSchema(reflect.TypeOf(sample), map[reflect.Type]bool{})
+	}
+	root.Type = "object"
+	if root.Properties == nil {
+		root.Properties = map[string]*JSONSchema{}
+	}
+
+	keys := make([]string, 0, len(rules))
+	for k := range rules {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		file := strings.HasPrefix(key, "file:")
+		parent, name, prop := schemaProperty(root, strings.TrimPrefix(key, "file:"))
+		if file {
+			prop.Type, prop.Format = "string", "binary"
+		}
+		// the type rules are applied first, the size rules depend on the type
+		var others []string
+		for _, rule := range rules[key] {
+			if isIn(strictTypeRules, rule) || isIn(numericTypeRules, rule) {
+				applySchemaRule(prop, rule)
+			} else {
+				others = append(others, rule)
+			}
+		}
+		for _, rule := range others {
+			switch {
+			case rule == "required":
+				if name != "" && !isIn(parent.Required, name) {
+					parent.Required = append(parent.Required, name)
+				}
+			case strings.HasPrefix(rule, eachRulePrefix):
+				if prop.Type == "" {
+					prop.Type = "array"
+				}
+				if prop.Items == nil {
+					prop.Items = &JSONSchema{}
+				}
+				applySchemaRule(prop.Items, strings.TrimPrefix(rule, eachRulePrefix))
+			default:
+				applySchemaRule(prop, rule)
+			}
+		}
+	}
+	return root
+}
+
+// schemaProperty return the schema of the key creating the missing properties, its parent and its name in the parent
+// the name is empty for the items of an array
+func schemaProperty(root *JSONSchema, key string) (*JSONSchema, string, *JSONSchema) {
+	if _, ok := root.Properties[key]; !ok && !strings.Contains(key, ".") {
+		if parent, prop := findSchemaProperty(root, key); prop != nil {
+			return parent, key, prop
+		}
+	}
+	node := root
+	segs := strings.Split(key, ".")
+	for i, seg := range segs {
+		last := i == len(segs)-1
+		if _, err := strconv.Atoi(seg); seg == "*" || (err == nil && i > 0) {
+			if node.Type == "" {
+				node.Type = "array"
+			}
+			if node.Items == nil {
+				node.Items = &JSONSchema{}
+			}
+			if last {
+				return node, "", node.Items
+			}
+			node = node.Items
+			continue
+		}
+		if node.Type == "" {
+			node.Type = "object"
+		}
+		if node.Properties == nil {
+			node.Properties = map[string]*JSONSchema{}
+		}
+		prop, ok := node.Properties[seg]
+		if !ok {
+			prop = &JSONSchema{}
+			node.Properties[seg] = prop
+		}
+		if last {
+			return node, seg, prop
+		}
+		node = prop
+	}
+	return node, "", node
+}
+
+// findSchemaProperty look up the property by name in the nested objects and items
+func findSchemaProperty(node *JSONSchema, name string) (*JSONSchema, *JSONSchema) {
+	if node == nil {
+		return nil, nil
+	}
+	if prop, ok := node.Properties[name]; ok {
+		return node, prop
+	}
+	keys := make([]string, 0, len(node.Properties))
+	for k := range node.Properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if parent, prop := findSchemaProperty(node.Properties[k], name); prop != nil {
+			return parent, prop
+		}
+	}
+	return findSchemaProperty(node.Items, name)
+}
+
+// applySchemaRule map the rule to the keywords of the schema, the unknown rules are added as x- extension
+func applySchemaRule(s *JSONSchema, rule string) {
+	name, arg := rule, ""
+	if i := strings.Index(rule, ":"); i >= 0 {
+		name, arg = rule[:i], rule[i+1:]
+	}
+	args := strings.Split(arg, ",")
+	if format, ok := schemaFormats[name]; ok {
+		// time.Time is already a date-time
+		if !(name == "date" && s.Format == "date-time") {
+			s.Format = format
+		}
+		return
+	}
+	switch name {
+	case "required":
+	case "integer":
+		s.Type = "integer"
+	case "number", "numeric", "float":
+		if s.Type != "integer" {
+			s.Type = "number"
+		}
+	case "string", "array", "object", "boolean", "null":
+		s.Type = name
+	case "min":
+		setSchemaSize(s, arg, "")
+	case "max":
+		setSchemaSize(s, "", arg)
+	case "len":
+		setSchemaSize(s, arg, arg)
+	case "between", "numeric_between":
+		if s.Format == "date" || s.Format == "date-time" || len(args) != 2 {
+			setSchemaExtension(s, name, arg)
+			return
+		}
+		if name == "numeric_between" && s.Type != "integer" {
+			s.Type = "number"
+		}
+		setSchemaSize(s, args[0], args[1])
+	case "in", "not_in":
+		enum := make([]interface{}, len(args))
+		for i, a := range args {
+			enum[i] = a
+			if s.Type == "integer" || s.Type == "number" {
+				if f, err := strconv.ParseFloat(a, 64); err == nil {
+					enum[i] = f
+				}
+			}
+		}
+		if name == "in" {
+			s.Enum = enum
+		} else {
+			s.Not = &JSONSchema{Enum: enum}
+		}
+	case "regex":
+		s.Pattern = arg
+	case "digits", "digits_between":
+		if s.Type == "integer" || s.Type == "number" {
+			setSchemaExtension(s, name, arg)
+			return
+		}
+		s.Pattern = "^[0-9]{" + arg + "}$"
+	case "size":
+		setSchemaSize(s, "", arg)
+	case "mime":
+		if len(args) == 1 {
+			s.ContentMediaType = arg
+			return
+		}
+		setSchemaExtension(s, name, args)
+	default:
+		setSchemaExtension(s, name, arg)
+	}
+}
+
+// setSchemaSize set the bounds of the value, the length, or the number of items by the type of the schema
+func setSchemaSize(s *JSONSchema, min, max string) {
+	num := func(arg string) *float64 {
+		f, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return nil
+		}
+		return &f
+	}
+	length := func(arg string) *int {
+		f := num(arg)
+		if f == nil {
+			return nil
+		}
+		l := int(*f)
+		return &l
+	}
+	switch s.Type {
+	case "integer", "number":
+		s.Minimum, s.Maximum = pickFloat(num(min), s.Minimum), pickFloat(num(max), s.Maximum)
+	case "array":
+		s.MinItems, s.MaxItems = pickInt(length(min), s.MinItems), pickInt(length(max), s.MaxItems)
+	default:
+		s.MinLength, s.MaxLength = pickInt(length(min), s.MinLength), pickInt(length(max), s.MaxLength)
+	}
+}
+
+// pickFloat return the value if not nil otherwise the current one
+func pickFloat(v, current *float64) *float64 {
+	if v != nil {
+		return v
+	}
+	return current
+}
+
+// pickInt return the value if not nil otherwise the current one
+func pickInt(v, current *int) *int {
+	if v != nil {
+		return v
+	}
+	return current
+}
+
+// setSchemaExtension add the rule as x- extension, the argument is the value or true if empty
+func setSchemaExtension(s *JSONSchema, name string, arg interface{}) {
+	if s.Extensions == nil {
+		s.Extensions = map[string]interface{}{}
+	}
+	if arg == "" {
+		arg = true
+	}
+	s.Extensions["x-"+name] = arg
+}
+
+// typeSchema return the schema of the Go type, the properties of a struct are named by the json tag
+// the value wrappers like Nullable are described by the type of the value, the other wrappers are left untyped
+func typeSchema(t reflect.Type, seen map[reflect.Type]bool) *JSONSchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t == timeType:
+		return &JSONSchema{Type: "string", Format: "date-time"}
+	case t == numberType:
+		return &JSONSchema{Type: "number"}
+	case t.Implements(nullableType) && t.Kind() == reflect.Struct:
+		if f, ok := t.FieldByName("V"); ok {
+			return typeSchema(f.Type, seen)
+		}
+	}
+	if implementsAny(t, marshalerType, valuerType, vValuerType) {
+		return &JSONSchema{}
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return &JSONSchema{Type: "string"}
+	case reflect.Bool:
+		return &JSONSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &JSONSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &JSONSchema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &JSONSchema{Type: "string", Format: "byte"}
+		}
+		return &JSONSchema{Type: "array", Items: typeSchema(t.Elem(), seen)}
+	case reflect.Map:
+		return &JSONSchema{Type: "object"}
+	case reflect.Struct:
+		if seen[t] {
+			return &JSONSchema{Type: "object"}
+		}
+		seen[t] = true
+		defer delete(seen, t)
+		s := &JSONSchema{Type: "object", Properties: map[string]*JSONSchema{}}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name := strings.Split(f.Tag.Get(tagIdentifier), ",")[0]
+			if name == "-" || (f.PkgPath != "" && !f.Anonymous) {
+				continue
+			}
+			prop := typeSchema(f.Type, seen)
+			// the fields of an embedded struct are promoted
+			if f.Anonymous && name == "" && prop.Type == "object" {
+				for k, p := range prop.Properties {
+					s.Properties[k] = p
+				}
+				continue
+			}
+			if f.PkgPath != "" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			s.Properties[name] = prop
+		}
+		return s
+	}
+	return &JSONSchema{}
+}
+
+// implementsAny check if the type or the pointer to the type implements any of the interfaces
+func implementsAny(t reflect.Type, ifaces ...reflect.Type) bool {
+	for _, i := range ifaces {
+		if t.Implements(i) || reflect.PtrTo(t).Implements(i) {
+			return true
+		}
+	}
+	return false
+}
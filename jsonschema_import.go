@@ -0,0 +1,240 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// dateTimePattern represents the regular expression of RFC 3339 date-time used for the date-time format
+const dateTimePattern = `^\d{4}-\d{2}-\d{2}[Tt]\d{2}:\d{2}:\d{2}(\.\d+)?([Zz]|[+-]\d{2}:\d{2})$`
+
+// schemaFormatRules represents the rules of the supported JSON Schema formats
+var schemaFormatRules = map[string]string{
+	"email":     "email",
+	"uuid":      "uuid",
+	"date":      "date",
+	"date-time": "regex:" + dateTimePattern,
+	"ipv4":      "ip_v4",
+	"ipv6":      "ip_v6",
+	"uri":       "url",
+}
+
+// schemaAnnotations represents the keywords having no effect on the validation
+var schemaAnnotations = []string{"$schema", "$id", "$comment", "$defs", "definitions", "title", "description", "default", "examples", "readOnly", "writeOnly", "deprecated"}
+
+// schemaImport represents the state of the JSON Schema conversion
+type schemaImport struct {
+	rules       MapData
+	unsupported []string
+}
+
+// FromJSONSchema convert the JSON Schema document to rules
+// the nested properties use dotted keys e.g: address.city, the items use wildcard keys e.g: items.*.sku
+// which are resolved against the form keys and the nested values of JSON body or struct
+// type, required, minLength, maxLength, minimum, maximum, minItems, maxItems, enum, pattern, items, properties and
+// the formats email, uuid, date, date-time, ipv4, ipv6 and uri are supported, the x- extensions of the rules are converted back
+// the unsupported keywords are reported in the error along with the rules converted from the rest of the document
+func FromJSONSchema(r io.Reader) (MapData, error) {
+	var doc map[string]interface{}
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("govalidator: invalid JSON Schema: %v", err)
+	}
+	if t, ok := doc["type"]; ok && t != "object" {
+		return nil, fmt.Errorf("govalidator: JSON Schema of type %v is not supported, the root must be an object", t)
+	}
+
+	si := &schemaImport{rules: MapData{}}
+	for k := range doc {
+		if k != "type" && k != "properties" && k != "required" && !isIn(schemaAnnotations, k) && !strings.HasPrefix(k, "x-") {
+			si.unsupport("", k)
+		}
+	}
+	si.object("", "", doc)
+	if len(si.unsupported) > 0 {
+		sort.Strings(si.unsupported)
+		return si.rules, fmt.Errorf("govalidator: unsupported JSON Schema keywords: %s", strings.Join(si.unsupported, ", "))
+	}
+	return si.rules, nil
+}
+
+// object convert the properties and the required list of the object schema
+func (si *schemaImport) object(key, path string, s map[string]interface{}) {
+	props, _ := s["properties"].(map[string]interface{})
+	for name, p := range props {
+		ps, ok := p.(map[string]interface{})
+		if !ok {
+			si.unsupport(path+"/properties/"+name, "properties")
+			continue
+		}
+		si.property(joinKey(key, name), path+"/properties/"+name, ps)
+	}
+	required, _ := s["required"].([]interface{})
+	for _, name := range required {
+		if n, ok := name.(string); ok {
+			k := joinKey(key, n)
+			si.rules[k] = append([]string{"required"}, si.rules[k]...)
+		}
+	}
+}
+
+// property convert the keywords of the property schema to the rules of the key
+func (si *schemaImport) property(key, path string, s map[string]interface{}) {
+	var rules []string
+	bounded := false
+	add := func(rule string) {
+		rules = append(rules, rule)
+	}
+
+	keywords := make([]string, 0, len(s))
+	for k := range s {
+		keywords = append(keywords, k)
+	}
+	sort.Strings(keywords)
+	// the type rule is added first, the size rules compare numbers using it as hint
+	if t, ok := schemaType(s["type"]); ok {
+		if t != "" {
+			add(t)
+		}
+	} else {
+		si.unsupport(path, "type")
+	}
+	for _, k := range keywords {
+		v := s[k]
+		switch k {
+		case "type", "required", "properties":
+		case "items":
+			items, ok := v.(map[string]interface{})
+			if !ok {
+				si.unsupport(path, k)
+				continue
+			}
+			si.property(key+wildcardSuffix, path+"/items", items)
+		case "minLength", "minItems":
+			add("min:" + schemaString(v))
+		case "maxLength", "maxItems":
+			add("max:" + schemaString(v))
+		case "minimum", "maximum":
+			// min and max accept integer argument only, numeric_between accepts the fractional and the missing bounds
+			if !bounded {
+				bounded = true
+				add("numeric_between:" + schemaBound(s["minimum"]) + "," + schemaBound(s["maximum"]))
+			}
+		case "pattern":
+			add("regex:" + schemaString(v))
+		case "format":
+			rule, ok := schemaFormatRules[schemaString(v)]
+			if !ok {
+				si.unsupport(path, "format "+schemaString(v))
+				continue
+			}
+			add(rule)
+		case "enum":
+			values, ok := v.([]interface{})
+			if !ok {
+				si.unsupport(path, k)
+				continue
+			}
+			enum := make([]string, len(values))
+			for i, e := range values {
+				enum[i] = schemaString(e)
+				if strings.Contains(enum[i], ",") {
+					ok = false
+				}
+			}
+			if !ok {
+				si.unsupport(path, k)
+				continue
+			}
+			add("in:" + strings.Join(enum, ","))
+		default:
+			if isIn(schemaAnnotations, k) {
+				continue
+			}
+			if rule := strings.TrimPrefix(k, "x-"); rule != k {
+				// the extensions of the unknown rules are ignored
+				if isRuleExist(rule) {
+					if arg := schemaString(v); arg != "true" {
+						rule += ":" + arg
+					}
+					add(rule)
+				}
+				continue
+			}
+			si.unsupport(path, k)
+		}
+	}
+	if len(rules) > 0 {
+		si.rules[key] = append(si.rules[key], rules...)
+	}
+	_, hasProps := s["properties"]
+	_, hasRequired := s["required"]
+	if hasProps || hasRequired {
+		si.object(key, path, s)
+	}
+}
+
+// unsupport record the unsupported keyword and its path
+func (si *schemaImport) unsupport(path, keyword string) {
+	if path == "" {
+		path = "/"
+	}
+	si.unsupported = append(si.unsupported, keyword+" at "+path)
+}
+
+// schemaType return the type rule of the type keyword, a nullable type like ["string", "null"] is validated by the type
+// false is returned for the other list of types
+func schemaType(v interface{}) (string, bool) {
+	switch t := v.(type) {
+	case nil:
+		return "", true
+	case string:
+		return t, isIn(strictTypeRules, t)
+	case []interface{}:
+		var types []string
+		for _, e := range t {
+			if s, ok := e.(string); ok && s != "null" {
+				types = append(types, s)
+			}
+		}
+		if len(types) == 1 && len(t) == 2 && isIn(strictTypeRules, types[0]) {
+			return types[0], true
+		}
+	}
+	return "", false
+}
+
+// schemaString return the string of the keyword value, numbers keep their representation
+func schemaString(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case []interface{}:
+		list := make([]string, len(s))
+		for i, e := range s {
+			list[i] = schemaString(e)
+		}
+		return strings.Join(list, ",")
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// schemaBound return the string of the minimum or maximum, empty if missing
+func schemaBound(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	return schemaString(v)
+}
+
+// joinKey return the dotted key of the property
+func joinKey(key, name string) string {
+	if key == "" {
+		return name
+	}
+	return key + "." + name
+}
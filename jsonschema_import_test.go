@@ -0,0 +1,163 @@
+package govalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFromJSONSchema(t *testing.T) {
+	doc := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title": "User",
+		"type": "object",
+		"required": ["name", "email"],
+		"properties": {
+			"name": {"type": "string", "minLength": 3, "maxLength": 8, "description": "user name"},
+			"email": {"type": "string", "format": "email"},
+			"age": {"type": ["integer", "null"], "minimum": 18, "maximum": 60.5},
+			"role": {"enum": ["admin", "user"]},
+			"code": {"type": "string", "pattern": "^[A-Z]{3}$", "x-alpha": true, "x-unknown_rule": 1},
+			"created": {"type": "string", "format": "date-time"},
+			"address": {
+				"type": "object",
+				"required": ["city"],
+				"properties": {"city": {"type": "string"}, "ip": {"format": "ipv4"}}
+			},
+			"tags": {"type": "array", "maxItems": 5, "items": {"type": "string", "format": "uuid"}},
+			"items": {"type": "array", "items": {"type": "object", "required": ["sku"], "properties": {"sku": {"format": "uri"}}}}
+		}
+	}`
+	rules, err := FromJSONSchema(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := MapData{
+		"name":         []string{"required", "string", "max:8", "min:3"},
+		"email":        []string{"required", "string", "email"},
+		"age":          []string{"integer", "numeric_between:18,60.5"},
+		"role":         []string{"in:admin,user"},
+		"code":         []string{"string", "regex:^[A-Z]{3}$", "alpha"},
+		"created":      []string{"string", "regex:" + dateTimePattern},
+		"address":      []string{"object"},
+		"address.city": []string{"required", "string"},
+		"address.ip":   []string{"ip_v4"},
+		"tags":         []string{"array", "max:5"},
+		"tags.*":       []string{"string", "uuid"},
+		"items":        []string{"array"},
+		"items.*":      []string{"object"},
+		"items.*.sku":  []string{"required", "url"},
+	}
+	if !reflect.DeepEqual(rules, expected) {
+		t.Errorf("FromJSONSchema failed: %v", rules)
+	}
+
+	// the dotted and wildcard keys validate the nested form fields
+	errs := New(Options{Rules: rules}).ValidateValues(url.Values{
+		"name":         []string{"jo"},
+		"email":        []string{"john@example.com"},
+		"age":          []string{"70"},
+		"created":      []string{"2020-01-02T15:04:05Z"},
+		"address.city": []string{"Dhaka"},
+		"tags[]":       []string{"not-uuid"},
+		"items.0.sku":  []string{"http://example.com/sku/1"},
+	})
+	if len(errs) != 3 || errs.Get("name") == "" || errs.Get("age") == "" || errs.Get("tags.0") == "" {
+		t.Errorf("FromJSONSchema rules failed to validate: %v", errs)
+	}
+
+	// the same rules validate the nested values of JSON body
+	validateJSON := func(body string) url.Values {
+		r, _ := http.NewRequest("POST", "/", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		data := map[string]interface{}{}
+		return New(Options{Request: r, Data: &data, Rules: rules}).ValidateJSON()
+	}
+	valid := `{"name": "john", "email": "john@example.com", "age": 20, "address": {"city": "Dhaka"},
+		"tags": ["b0f4a0a4-9c4e-4d4e-8f0a-1c2d3e4f5a6b"], "items": [{"sku": "http://example.com/sku/1"}]}`
+	if errs := validateJSON(valid); len(errs) != 0 {
+		t.Errorf("FromJSONSchema rules failed to validate valid JSON body: %v", errs)
+	}
+	errs = validateJSON(`{"name": "john", "email": "john@example.com", "address": {"city": 10, "ip": "x"},
+		"tags": ["not-uuid"], "items": [{"sku": "http://example.com/sku/1"}, {}, "sku"]}`)
+	for _, field := range []string{"address.city", "address.ip", "tags.0", "items.1.sku", "items.2", "items.2.sku"} {
+		if errs.Get(field) == "" {
+			t.Errorf("FromJSONSchema rules failed to report %s of JSON body: %v", field, errs)
+		}
+	}
+	if len(errs) != 6 {
+		t.Errorf("FromJSONSchema rules reported unexpected errors of JSON body: %v", errs)
+	}
+}
+
+func TestFromJSONSchema_numberRange(t *testing.T) {
+	rules, err := FromJSONSchema(strings.NewReader(`{"type": "object", "properties": {"price": {"type": "number", "minimum": 0, "maximum": 10}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for body, valid := range map[string]bool{`{"price": 5.5}`: true, `{"price": 10}`: true, `{"price": 10.5}`: false} {
+		r, _ := http.NewRequest("POST", "/", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		data := map[string]interface{}{}
+		if errs := New(Options{Request: r, Data: &data, Rules: rules}).ValidateJSON(); (len(errs) == 0) != valid {
+			t.Errorf("FromJSONSchema rules failed to validate the number range of %s: %v", body, errs)
+		}
+	}
+}
+
+func TestFromJSONSchema_unsupported(t *testing.T) {
+	doc := `{
+		"type": "object",
+		"additionalProperties": false,
+		"properties": {
+			"name": {"type": "string", "anyOf": [], "format": "hostname"},
+			"value": {"type": ["string", "integer"], "minimum": 1},
+			"list": {"items": [{"type": "string"}]},
+			"enum": {"enum": ["a,b"]}
+		}
+	}`
+	rules, err := FromJSONSchema(strings.NewReader(doc))
+	if err == nil {
+		t.Fatal("FromJSONSchema failed to report the unsupported keywords")
+	}
+	for _, k := range []string{"additionalProperties at /", "anyOf at /properties/name", "format hostname at /properties/name",
+		"type at /properties/value", "items at /properties/list", "enum at /properties/enum"} {
+		if !strings.Contains(err.Error(), k) {
+			t.Errorf("FromJSONSchema failed to report %s: %v", k, err)
+		}
+	}
+	if !reflect.DeepEqual(rules["value"], []string{"numeric_between:1,"}) || !reflect.DeepEqual(rules["name"], []string{"string"}) {
+		t.Errorf("FromJSONSchema failed to convert the supported keywords: %v", rules)
+	}
+
+	for _, doc := range []string{`{"type": "array"}`, `{"type": `} {
+		if _, err := FromJSONSchema(strings.NewReader(doc)); err == nil {
+			t.Errorf("FromJSONSchema failed to report the invalid document: %s", doc)
+		}
+	}
+}
+
+func TestFromJSONSchema_ToJSONSchema(t *testing.T) {
+	rules := MapData{
+		"name":        []string{"required", "string", "between:3,8", "alpha"},
+		"items.*.sku": []string{"required", "email"},
+	}
+	b, _ := json.Marshal(ToJSONSchema(rules, nil))
+	back, err := FromJSONSchema(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := MapData{
+		"name":        []string{"required", "string", "max:8", "min:3", "alpha"},
+		"items":       []string{"array"},
+		"items.*":     []string{"object"},
+		"items.*.sku": []string{"required", "email"},
+	}
+	if !reflect.DeepEqual(back, expected) {
+		t.Errorf("FromJSONSchema failed to convert the exported schema: %v", back)
+	}
+}
@@ -0,0 +1,101 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type schemaAddress struct {
+	City string `json:"city"`
+	Zip  string `json:"zip"`
+}
+
+type schemaItem struct {
+	SKU   string  `json:"sku"`
+	Price float64 `json:"price"`
+}
+
+type schemaUser struct {
+	schemaAddress
+	Name     string       `json:"name"`
+	Email    string       `json:"email"`
+	Age      Int          `json:"age"`
+	Role     string       `json:"role"`
+	Tags     []string     `json:"tags"`
+	Items    []schemaItem `json:"items"`
+	Birthday time.Time    `json:"birthday"`
+	Secret   string       `json:"-"`
+	internal string
+}
+
+func TestToJSONSchema(t *testing.T) {
+	rules := MapData{
+		"name":        []string{"required", "between:3,8", "alpha"},
+		"email":       []string{"required", "email"},
+		"age":         []string{"min:18", "in:18,21"},
+		"role":        []string{"not_in:root", "regex:^[a-z]+$"},
+		"tags":        []string{"max:5", "each:uuid_v4"},
+		"items.*.sku": []string{"required", "digits:8"},
+		"price":       []string{"numeric_between:1,"},
+		"zip":         []string{"len:5", "__custom__:a"},
+		"birthday":    []string{"date"},
+		"file:photo":  []string{"mime:image/png", "size:1024"},
+	}
+	s := ToJSONSchema(rules, schemaUser{})
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]interface{}
+	json.Unmarshal(b, &doc)
+	props := doc["properties"].(map[string]interface{})
+	prop := func(name string) map[string]interface{} {
+		return props[name].(map[string]interface{})
+	}
+	items := prop("items")["items"].(map[string]interface{})
+
+	list := map[string]struct {
+		value    interface{}
+		expected interface{}
+	}{
+		"type":             {doc["type"], "object"},
+		"required":         {doc["required"], []interface{}{"email", "name"}},
+		"name length":      {[]interface{}{prop("name")["minLength"], prop("name")["maxLength"]}, []interface{}{3.0, 8.0}},
+		"name extension":   {prop("name")["x-alpha"], true},
+		"email format":     {prop("email")["format"], "email"},
+		"age":              {[]interface{}{prop("age")["type"], prop("age")["minimum"], prop("age")["enum"]}, []interface{}{"integer", 18.0, []interface{}{18.0, 21.0}}},
+		"role":             {[]interface{}{prop("role")["not"], prop("role")["pattern"]}, []interface{}{map[string]interface{}{"enum": []interface{}{"root"}}, "^[a-z]+$"}},
+		"tags":             {[]interface{}{prop("tags")["maxItems"], prop("tags")["items"]}, []interface{}{5.0, map[string]interface{}{"type": "string", "format": "uuid"}}},
+		"items required":   {items["required"], []interface{}{"sku"}},
+		"items sku":        {items["properties"].(map[string]interface{})["sku"], map[string]interface{}{"type": "string", "pattern": "^[0-9]{8}$"}},
+		"nested price":     {items["properties"].(map[string]interface{})["price"].(map[string]interface{})["minimum"], 1.0},
+		"embedded zip":     {prop("zip"), map[string]interface{}{"type": "string", "minLength": 5.0, "maxLength": 5.0, "x-__custom__": "a"}},
+		"birthday":         {prop("birthday")["format"], "date-time"},
+		"photo":            {prop("photo"), map[string]interface{}{"type": "string", "format": "binary", "contentMediaType": "image/png", "maxLength": 1024.0}},
+		"skip ignored tag": {props["Secret"] == nil && props["internal"] == nil, true},
+	}
+	for name, l := range list {
+		if !reflect.DeepEqual(l.value, l.expected) {
+			t.Errorf("ToJSONSchema failed for %s: expected %v got %v", name, l.expected, l.value)
+		}
+	}
+}
+
+func TestToJSONSchema_withoutSample(t *testing.T) {
+	s := ToJSONSchema(MapData{
+		"age":              []string{"integer", "between:18,60"},
+		"address.city":     []string{"required"},
+		"matrix.*.*":       []string{"numeric"},
+		"contacts.0.phone": []string{"digits_between:5,11"},
+	}, nil)
+	b, _ := json.Marshal(s)
+	expected := `{"type":"object","properties":{"address":{"type":"object","properties":{"city":{}},"required":["city"]},` +
+		`"age":{"type":"integer","minimum":18,"maximum":60},` +
+		`"contacts":{"type":"array","items":{"type":"object","properties":{"phone":{"pattern":"^[0-9]{5,11}$"}}}},` +
+		`"matrix":{"type":"array","items":{"type":"array","items":{"type":"number"}}}}}`
+	if string(b) != expected {
+		t.Errorf("ToJSONSchema failed: %s", b)
+	}
+}
@@ -0,0 +1,191 @@
+package govalidator
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Locale represents a bundle of the default messages and the display names of the fields of a language
+type Locale struct {
+	Messages   map[string]string // Messages represents the message templates keyed by rule, e.g: email or min.string
+	Attributes map[string]string // Attributes represents the display names of the fields, e.g: username or items.*.sku
+}
+
+// defaultLocale represents the language tag used if the requested locale is not available
+const defaultLocale = "en"
+
+// locales represents the registered locale bundles keyed by language tag
+// the bundles hold a copy of the messages so AddLocale does not change the built-in messages
+var locales = map[string]*Locale{
+	defaultLocale: {Messages: copyMessages(defaultMessages), Attributes: map[string]string{}},
+	"bn":          {Messages: copyMessages(bnMessages), Attributes: map[string]string{}},
+}
+
+// copyMessages return a copy of the message templates
+func copyMessages(messages map[string]string) map[string]string {
+	c := make(map[string]string, len(messages))
+	for k, m := range messages {
+		c[k] = m
+	}
+	return c
+}
+
+// localeCtxKey represents the context key of the locale
+type localeCtxKey struct{}
+
+// AddLocale register a locale bundle using the language tag, e.g: bn or bn-BD
+// the messages and attributes are merged into the bundle if the tag is already registered
+func AddLocale(tag string, locale Locale) {
+	tag = normalizeLocale(tag)
+	l, ok := locales[tag]
+	if !ok {
+		l = &Locale{Messages: map[string]string{}, Attributes: map[string]string{}}
+		locales[tag] = l
+	}
+	for k, m := range locale.Messages {
+		l.Messages[k] = m
+	}
+	for k, a := range locale.Attributes {
+		l.Attributes[k] = a
+	}
+}
+
+// WithLocale return a copy of the context carrying the language tag
+// the locale of the request context is used instead of the Accept-Language header
+func WithLocale(ctx context.Context, tag string) context.Context {
+	return context.WithValue(ctx, localeCtxKey{}, tag)
+}
+
+// normalizeLocale return the lower case language tag using hyphen as separator, e.g: bn_BD becomes bn-bd
+func normalizeLocale(tag string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(tag), "_", "-", -1))
+}
+
+// localeChain return the registered bundles of the language tag falling back to the parent tags
+// e.g: bn-bd, bn for bn-BD-x
+func localeChain(tag string) []*Locale {
+	var chain []*Locale
+	tag = normalizeLocale(tag)
+	for tag != "" {
+		if l, ok := locales[tag]; ok {
+			chain = append(chain, l)
+		}
+		i := strings.LastIndex(tag, "-")
+		if i < 0 {
+			break
+		}
+		tag = tag[:i]
+	}
+	return chain
+}
+
+// parseAcceptLanguage return the language tags of the Accept-Language header ordered by the quality value
+func parseAcceptLanguage(header string) []string {
+	type lang struct {
+		tag string
+		q   float64
+	}
+	var langs []lang
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		tag := strings.TrimSpace(fields[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		for _, f := range fields[1:] {
+			f = strings.TrimSpace(f)
+			if strings.HasPrefix(f, "q=") {
+				if v, err := strconv.ParseFloat(strings.TrimPrefix(f, "q="), 64); err == nil {
+					q = v
+				}
+			}
+		}
+		if q > 0 {
+			langs = append(langs, lang{tag, q})
+		}
+	}
+	sort.SliceStable(langs, func(i, j int) bool { return langs[i].q > langs[j].q })
+	tags := make([]string, len(langs))
+	for i, l := range langs {
+		tags[i] = l.tag
+	}
+	return tags
+}
+
+// locales return the bundles of the locale selected by Options.Locale, the request context or the Accept-Language header
+// the default locale is always the last one of the bundles
+func (v *Validator) locales() []*Locale {
+	var tags []string
+	switch {
+	case v.Opts.Locale != "":
+		tags = []string{v.Opts.Locale}
+	case v.Opts.Request != nil:
+		if tag, ok := v.Opts.Request.Context().Value(localeCtxKey{}).(string); ok {
+			tags = []string{tag}
+		} else {
+			tags = parseAcceptLanguage(v.Opts.Request.Header.Get("Accept-Language"))
+		}
+	}
+	def := locales[defaultLocale]
+	for _, tag := range tags {
+		if chain := localeChain(tag); len(chain) > 0 {
+			if chain[len(chain)-1] != def {
+				chain = append(chain, def)
+			}
+			return chain
+		}
+	}
+	return []*Locale{def}
+}
+
+// localizer represents the message templates and the display names of the fields of the selected locale
+// it is built once per validation as selecting the locale parses the request headers
+type localizer struct {
+	templates templateFunc
+	attrs     []map[string]string
+}
+
+// newLocalizer return the localizer using the templates of Options.DefaultMessages, SetDefaultMessage and
+// the selected locale in order, the template of the rule is used for a suffixed key if it is not provided, e.g: min for min.string
+// the display names are looked up in Options.Attributes and the selected locale in order
+func (v *Validator) newLocalizer() *localizer {
+	layers := []map[string]string{v.Opts.DefaultMessages, messageOverrides}
+	attrs := []map[string]string{v.Opts.Attributes}
+	for _, l := range v.locales() {
+		layers = append(layers, l.Messages)
+		attrs = append(attrs, l.Attributes)
+	}
+	templates := func(key string) (string, bool) {
+		for _, messages := range layers {
+			if tmpl, ok := lookupTemplate(messages, key); ok {
+				return tmpl, true
+			}
+		}
+		return "", false
+	}
+	return &localizer{templates: templates, attrs: attrs}
+}
+
+// attribute return the display name of the field used in the messages
+// the name of wildcard key is used for the indexed field, if not available it return the field
+func (l *localizer) attribute(field string) string {
+	for _, a := range l.attrs {
+		if name, ok := a[field]; ok {
+			return name
+		}
+		if name, ok := a[wildcardKey(field)]; ok {
+			return name
+		}
+	}
+	return field
+}
+
+// message render the template of the key using the display name of the field
+// it is used for the errors having no rule function e.g: the decode errors
+func (l *localizer) message(key, field string, params ...string) string {
+	tmpl, _ := l.templates(key)
+	return renderMessage(tmpl, l.attribute(field), nil, params...)
+}
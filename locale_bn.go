@@ -0,0 +1,75 @@
+package govalidator
+
+// bnMessages represents the Bengali message templates of the built-in rules
+var bnMessages = map[string]string{
+	"required":            "{attribute} ফিল্ডটি আবশ্যক",
+	"regex":               "{attribute} ফিল্ডের ফরম্যাট সঠিক নয়",
+	"alpha":               "{attribute} ফিল্ডে শুধুমাত্র অক্ষর থাকতে পারে",
+	"alpha_dash":          "{attribute} ফিল্ডে শুধুমাত্র অক্ষর, সংখ্যা এবং ড্যাশ থাকতে পারে",
+	"alpha_space":         "{attribute} ফিল্ডে শুধুমাত্র অক্ষর, সংখ্যা, ড্যাশ এবং স্পেস থাকতে পারে",
+	"alpha_num":           "{attribute} ফিল্ডে শুধুমাত্র অক্ষর এবং সংখ্যা থাকতে পারে",
+	"bool":                "{attribute} ফিল্ডে শুধুমাত্র বুলিয়ান মান, স্ট্রিং অথবা ০, ১ থাকতে পারে",
+	"between":             "{attribute} ফিল্ডটি অবশ্যই {min} এবং {max} এর মধ্যে হতে হবে",
+	"credit_card":         "{attribute} ফিল্ডটি অবশ্যই একটি সঠিক ক্রেডিট কার্ড নাম্বার হতে হবে",
+	"coordinate":          "{attribute} ফিল্ডটি অবশ্যই একটি সঠিক কোঅর্ডিনেট হতে হবে",
+	"css_color":           "{attribute} ফিল্ডটি অবশ্যই একটি সঠিক CSS কালার কোড হতে হবে",
+	"digits":              "{attribute} ফিল্ডটি অবশ্যই {digits} অঙ্কের হতে হবে",
+	"digits.one":          "{attribute} ফিল্ডটি অবশ্যই ১ অঙ্কের হতে হবে",
+	"digits_between":      "{attribute} ফিল্ডটি অবশ্যই {min} থেকে {max} অঙ্কের মধ্যে হতে হবে",
+	"date":                "{attribute} ফিল্ডটি অবশ্যই একটি সঠিক তারিখ হতে হবে। যেমন: yyyy-mm-dd, yyyy/mm/dd ইত্যাদি",
+	"date.dd-mm-yyyy":     "{attribute} ফিল্ডটি অবশ্যই একটি সঠিক তারিখ হতে হবে। যেমন: dd-mm-yyyy, dd/mm/yyyy ইত্যাদি",
+	"email":               "{attribute} ফিল্ডটি অবশ্যই একটি সঠিক ইমেইল ঠিকানা হতে হবে",
+	"float":               "{attribute} ফিল্ডটি অবশ্যই একটি দশমিক সংখ্যা হতে হবে",
+	"ip":                  "{attribute} ফিল্ডটি অবশ্যই একটি সঠিক IP ঠিকানা হতে হবে",
+	"ip_v4":               "{attribute} ফিল্ডটি অবশ্যই একটি সঠিক IPv4 ঠিকানা হতে হবে",
+	"ip_v6":               "{attribute} ফিল্ডটি অবশ্যই একটি সঠিক IPv6 ঠিকানা হতে হবে",
+	"json":                "{attribute} ফিল্ডে অবশ্যই সঠিক JSON স্ট্রিং থাকতে হবে",
+	"lat":                 "{attribute} ফিল্ডে অবশ্যই সঠিক অক্ষাংশ থাকতে হবে",
+	"lon":                 "{attribute} ফিল্ডে অবশ্যই সঠিক দ্রাঘিমাংশ থাকতে হবে",
+	"len":                 "{attribute} ফিল্ডের দৈর্ঘ্য অবশ্যই {len} হতে হবে",
+	"min.numeric":         "{attribute} ফিল্ডের মান {min} এর কম হতে পারবে না",
+	"min.string":          "{attribute} ফিল্ডে অবশ্যই কমপক্ষে {min} অক্ষর থাকতে হবে",
+	"min.array":           "{attribute} ফিল্ডে অবশ্যই কমপক্ষে {min} টি আইটেম থাকতে হবে",
+	"max.numeric":         "{attribute} ফিল্ডের মান {max} এর বেশি হতে পারবে না",
+	"max.string":          "{attribute} ফিল্ডে সর্বোচ্চ {max} অক্ষর থাকতে পারবে",
+	"max.array":           "{attribute} ফিল্ডে সর্বোচ্চ {max} টি আইটেম থাকতে পারবে",
+	"mac_address":         "{attribute} ফিল্ডটি অবশ্যই একটি সঠিক ম্যাক অ্যাড্রেস হতে হবে",
+	"numeric":             "{attribute} ফিল্ডটি অবশ্যই সংখ্যা হতে হবে",
+	"integer":             "{attribute} ফিল্ডটি অবশ্যই একটি পূর্ণসংখ্যা হতে হবে",
+	"numeric_between":     "{attribute} ফিল্ডটি অবশ্যই {min} এবং {max} এর মধ্যে একটি সংখ্যা হতে হবে",
+	"numeric_between.min": "{attribute} ফিল্ডের মান {min} এর কম হতে পারবে না",
+	"numeric_between.max": "{attribute} ফিল্ডের মান {max} এর বেশি হতে পারবে না",
+	"url":                 "{attribute} ফিল্ডের ফরম্যাট সঠিক নয়",
+	"uuid":                "{attribute} ফিল্ডে অবশ্যই সঠিক UUID থাকতে হবে",
+	"uuid_v3":             "{attribute} ফিল্ডে অবশ্যই সঠিক UUID V3 থাকতে হবে",
+	"uuid_v4":             "{attribute} ফিল্ডে অবশ্যই সঠিক UUID V4 থাকতে হবে",
+	"uuid_v5":             "{attribute} ফিল্ডে অবশ্যই সঠিক UUID V5 থাকতে হবে",
+	"in":                  "{attribute} ফিল্ডটি অবশ্যই {values} এর যেকোনো একটি হতে হবে",
+	"not_in":              "{attribute} ফিল্ডটি {values} এর কোনোটি হতে পারবে না",
+	"string":              "{attribute} ফিল্ডটি অবশ্যই একটি স্ট্রিং হতে হবে",
+	"number":              "{attribute} ফিল্ডটি অবশ্যই একটি সংখ্যা হতে হবে",
+	"array":               "{attribute} ফিল্ডটি অবশ্যই একটি অ্যারে হতে হবে",
+	"object":              "{attribute} ফিল্ডটি অবশ্যই একটি অবজেক্ট হতে হবে",
+	"boolean":             "{attribute} ফিল্ডটি অবশ্যই একটি বুলিয়ান হতে হবে",
+	"null":                "{attribute} ফিল্ডটি অবশ্যই null হতে হবে",
+	"before":              "{attribute} ফিল্ডটি অবশ্যই {date} এর আগের তারিখ হতে হবে",
+	"after":               "{attribute} ফিল্ডটি অবশ্যই {date} এর পরের তারিখ হতে হবে",
+	"size":                "{attribute} ফিল্ডের ফাইলের আকার {size} বাইটের বেশি হতে পারবে না",
+	"ext":                 "{attribute} ফিল্ডের ফাইল এক্সটেনশন {ext} সঠিক নয়",
+	"mime":                "{attribute} ফিল্ডের ফাইল মাইম {mime} সঠিক নয়",
+	// the messages of the decode and the request body errors
+	"type":                   "{attribute} ফিল্ডটি অবশ্যই একটি সঠিক {type} হতে হবে",
+	"type.boolean":           "{attribute} ফিল্ডটি অবশ্যই একটি বুলিয়ান হতে হবে",
+	"type.integer":           "{attribute} ফিল্ডটি অবশ্যই একটি পূর্ণসংখ্যা হতে হবে",
+	"type.number":            "{attribute} ফিল্ডটি অবশ্যই একটি সংখ্যা হতে হবে",
+	"type.string":            "{attribute} ফিল্ডটি অবশ্যই একটি স্ট্রিং হতে হবে",
+	"type.object":            "{attribute} ফিল্ডটি অবশ্যই একটি অবজেক্ট হতে হবে",
+	"type.array":             "{attribute} ফিল্ডটি অবশ্যই একটি অ্যারে হতে হবে",
+	"type.date":              "{attribute} ফিল্ডটি অবশ্যই একটি সঠিক তারিখ হতে হবে",
+	"utf8":                   "{attribute} ফিল্ডটি অবশ্যই একটি সঠিক UTF-8 স্ট্রিং হতে হবে",
+	"unknown":                "অজানা ফিল্ড",
+	"duplicate":              "ডুপ্লিকেট ফিল্ড",
+	"syntax":                 "লাইন {line}, কলাম {column} এ ত্রুটি: {error}",
+	"body_size":              "রিকোয়েস্ট বডি {size} বাইটের বেশি হতে পারবে না",
+	"body_size.decompressed": "ডিকম্প্রেস করা রিকোয়েস্ট বডি {size} বাইটের বেশি হতে পারবে না",
+}
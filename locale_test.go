@@ -0,0 +1,95 @@
+package govalidator
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_bnMessages(t *testing.T) {
+	for key := range defaultMessages {
+		if _, ok := bnMessages[key]; !ok {
+			t.Errorf("Bengali locale is missing the message of %s", key)
+		}
+	}
+}
+
+func Test_parseAcceptLanguage(t *testing.T) {
+	tags := parseAcceptLanguage("en;q=0.5, bn-BD,fr;q=0, *;q=0.1, de;q=0.8")
+	if !reflect.DeepEqual(tags, []string{"bn-BD", "de", "en"}) {
+		t.Errorf("parseAcceptLanguage failed: %v", tags)
+	}
+}
+
+func TestValidator_Locale(t *testing.T) {
+	AddLocale("bn_TEST", Locale{
+		Attributes: map[string]string{"name": "নাম"},
+	})
+	validate := func(opts Options) string {
+		opts.Rules = MapData{"name": []string{"required"}}
+		return New(opts).ValidateValues(url.Values{}).Get("name")
+	}
+	req := func(header string) *http.Request {
+		r, _ := http.NewRequest("GET", "/", nil)
+		r.Header.Set("Accept-Language", header)
+		return r
+	}
+	ctxReq := req("fr")
+	ctxReq = ctxReq.WithContext(WithLocale(ctxReq.Context(), "bn"))
+
+	list := map[string]struct {
+		opts     Options
+		expected string
+	}{
+		"default":         {Options{}, "The name field is required"},
+		"accept language": {Options{Request: req("fr-CA, bn-BD;q=0.8")}, "name ফিল্ডটি আবশ্যক"},
+		"unknown":         {Options{Request: req("fr-CA")}, "The name field is required"},
+		"context":         {Options{Request: ctxReq}, "name ফিল্ডটি আবশ্যক"},
+		"option":          {Options{Request: req("bn"), Locale: "en-US"}, "The name field is required"},
+		"attributes":      {Options{Locale: "bn-test"}, "নাম ফিল্ডটি আবশ্যক"},
+		"custom":          {Options{Locale: "bn", Messages: MapData{"name": []string{"required:{attribute} is missing"}}}, "name is missing"},
+	}
+	for name, l := range list {
+		if msg := validate(l.opts); msg != l.expected {
+			t.Errorf("locale failed for %s: expected %q got %q", name, l.expected, msg)
+		}
+	}
+}
+
+func TestAddLocale_defaultMessages(t *testing.T) {
+	en := locales[defaultLocale].Messages["required"]
+	defer func() { locales[defaultLocale].Messages["required"] = en }()
+
+	AddLocale("en", Locale{Messages: map[string]string{"required": "{attribute} is missing"}})
+	if defaultMessages["required"] != "The {attribute} field is required" {
+		t.Errorf("AddLocale changed the default message: %q", defaultMessages["required"])
+	}
+	if msg := New(Options{Rules: MapData{"name": []string{"required"}}}).ValidateValues(url.Values{}).Get("name"); msg != "name is missing" {
+		t.Errorf("AddLocale failed to change the message of en: %q", msg)
+	}
+}
+
+func TestValidator_Locale_decodeErrors(t *testing.T) {
+	type user struct {
+		Age int `json:"age"`
+	}
+	body := `{"age": "ten"}`
+	r, _ := http.NewRequest("POST", "/", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	var u user
+	opts := Options{Request: r, Data: &u, Rules: MapData{"age": []string{"integer"}}, Locale: "bn"}
+	errs := New(opts).ValidateJSON()
+	if msg := errs.Get("age"); msg != "age ফিল্ডটি অবশ্যই একটি পূর্ণসংখ্যা হতে হবে" {
+		t.Errorf("decode error is not localized: %q", msg)
+	}
+
+	r, _ = http.NewRequest("POST", "/", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	opts.Request, opts.MaxBodyBytes = r, 4
+	errs = New(opts).ValidateJSON()
+	if msg := errs.Get("_error"); msg != "রিকোয়েস্ট বডি 4 বাইটের বেশি হতে পারবে না" {
+		t.Errorf("body error is not localized: %q", msg)
+	}
+}
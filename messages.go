@@ -0,0 +1,197 @@
+package govalidator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// defaultMessages represents the message templates of the built-in rules
+// the placeholders like {attribute}, {value}, {value_len} and the rule arguments e.g: {min}, {max} are replaced while rendering
+// the rules having different messages by the type of the value or the arguments use a suffixed key e.g: min.string
+var defaultMessages = map[string]string{
+	"required":            "The {attribute} field is required",
+	"regex":               "The {attribute} field format is invalid",
+	"alpha":               "The {attribute} may only contain letters",
+	"alpha_dash":          "The {attribute} may only contain letters, numbers, and dashes",
+	"alpha_space":         "The {attribute} may only contain letters, numbers, dashes, space",
+	"alpha_num":           "The {attribute} may only contain letters and numbers",
+	"bool":                "The {attribute} may only contain boolean value, string or int 0, 1",
+	"between":             "The {attribute} field must be between {min} and {max}",
+	"credit_card":         "The {attribute} field must be a valid credit card number",
+	"coordinate":          "The {attribute} field must be a valid coordinate",
+	"css_color":           "The {attribute} field must be a valid CSS color code",
+	"digits":              "The {attribute} field must be {digits} digits",
+	"digits.one":          "The {attribute} field must be 1 digit",
+	"digits_between":      "The {attribute} field must be digits between {min} and {max}",
+	"date":                "The {attribute} field must be a valid date format. e.g: yyyy-mm-dd, yyyy/mm/dd etc",
+	"date.dd-mm-yyyy":     "The {attribute} field must be a valid date format. e.g: dd-mm-yyyy, dd/mm/yyyy etc",
+	"email":               "The {attribute} field must be a valid email address",
+	"float":               "The {attribute} field must be a float number",
+	"ip":                  "The {attribute} field must be a valid IP address",
+	"ip_v4":               "The {attribute} field must be a valid IPv4 address",
+	"ip_v6":               "The {attribute} field must be a valid IPv6 address",
+	"json":                "The {attribute} field must contain valid JSON string",
+	"lat":                 "The {attribute} field must contain valid latitude",
+	"lon":                 "The {attribute} field must contain valid longitude",
+	"len":                 "The {attribute} field must be length of {len}",
+	"min.numeric":         "The {attribute} field value can not be less than {min}",
+	"min.string":          "The {attribute} field must be minimum {min} char",
+	"min.array":           "The {attribute} field must be minimum {min} in size",
+	"max.numeric":         "The {attribute} field value can not be greater than {max}",
+	"max.string":          "The {attribute} field must be maximum {max} char",
+	"max.array":           "The {attribute} field must be maximum {max} in size",
+	"mac_address":         "The {attribute} field must be a valid Mac Address",
+	"numeric":             "The {attribute} field must be numeric",
+	"integer":             "The {attribute} field must be an integer",
+	"numeric_between":     "The {attribute} field must be numeric value between {min} and {max}",
+	"numeric_between.min": "The {attribute} field value can not be less than {min}",
+	"numeric_between.max": "The {attribute} field value can not be greater than {max}",
+	"url":                 "The {attribute} field format is invalid",
+	"uuid":                "The {attribute} field must contain valid UUID",
+	"uuid_v3":             "The {attribute} field must contain valid UUID V3",
+	"uuid_v4":             "The {attribute} field must contain valid UUID V4",
+	"uuid_v5":             "The {attribute} field must contain valid UUID V5",
+	"in":                  "The {attribute} field must be one of {values}",
+	"not_in":              "The {attribute} field must not be any of {values}",
+	"string":              "The {attribute} field must be a string",
+	"number":              "The {attribute} field must be a number",
+	"array":               "The {attribute} field must be an array",
+	"object":              "The {attribute} field must be an object",
+	"boolean":             "The {attribute} field must be a boolean",
+	"null":                "The {attribute} field must be null",
+	"before":              "The {attribute} field must be a date before {date}",
+	"after":               "The {attribute} field must be a date after {date}",
+	"size":                "The {attribute} field size is can not be greater than {size} bytes",
+	"ext":                 "The {attribute} field file extension {ext} is invalid",
+	"mime":                "The {attribute} field file mime {mime} is invalid",
+	// the messages of the decode and the request body errors, see messageRules
+	"type":                   "The {attribute} field must be a valid {type}",
+	"type.boolean":           "The {attribute} field must be a boolean",
+	"type.integer":           "The {attribute} field must be an integer",
+	"type.number":            "The {attribute} field must be a number",
+	"type.string":            "The {attribute} field must be a string",
+	"type.object":            "The {attribute} field must be an object",
+	"type.array":             "The {attribute} field must be an array",
+	"type.date":              "The {attribute} field must be a valid date",
+	"utf8":                   "The {attribute} field must be a valid UTF-8 string",
+	"unknown":                "unknown field",
+	"duplicate":              "duplicate field",
+	"syntax":                 "{error} at line {line}, column {column}",
+	"body_size":              "The request body can not be greater than {size} bytes",
+	"body_size.decompressed": "The decompressed request body can not be greater than {size} bytes",
+}
+
+// messageRules represents the pseudo rules having a message but no rule function
+var messageRules = []string{"type", "utf8", "syntax", "unknown", "duplicate", "body_size"}
+
+// messageError represents the error of a built-in rule, it keeps the template key and the placeholders
+// so the message can be rendered again using the template of another locale
+type messageError struct {
+	key    string
+	tmpl   string
+	custom bool
+	field  string
+	value  interface{}
+	params []string
+}
+
+// Error return the rendered message
+func (e *messageError) Error() string {
+	return renderMessage(e.tmpl, e.field, e.value, e.params...)
+}
+
+// templateFunc return the message template of the key if available
+type templateFunc func(key string) (string, bool)
+
+// messageOverrides represents the default message templates set by SetDefaultMessage
+var messageOverrides = map[string]string{}
+
+// SetDefaultMessage change the default message template of the rule for all the validators and locales
+// the key is the rule name e.g: email or a suffixed key to change a variant of the message e.g: min.string
+// the messages of Options.Messages and Validator.SetDefaultMessage take precedence
+func SetDefaultMessage(key, tmpl string) {
+	mustMessageKey(key)
+	messageOverrides[key] = tmpl
+}
+
+// mustMessageKey panic if the rule of the message key does not exist
+func mustMessageKey(key string) {
+	if rule := messageKeyRule(key); !isRuleExist(rule) && !isIn(messageRules, rule) {
+		panic(fmt.Errorf("govalidator: %s is not a valid rule", rule))
+	}
+}
+
+// messageKeyRule return the rule name of the message key, e.g: min for min.string
+func messageKeyRule(key string) string {
+	return strings.SplitN(key, ".", 2)[0]
+}
+
+// lookupTemplate return the template of the key or the template of the rule from the messages
+func lookupTemplate(messages map[string]string, key string) (string, bool) {
+	if tmpl, ok := messages[key]; ok {
+		return tmpl, true
+	}
+	tmpl, ok := messages[messageKeyRule(key)]
+	return tmpl, ok
+}
+
+// ruleError return the error of the rule using the custom message if provided otherwise the default template of the key
+// params are the pairs of placeholder name and value e.g: "min", "3"
+func ruleError(key, message, field string, value interface{}, params ...string) error {
+	e := &messageError{key: key, tmpl: message, custom: message != "", field: field, value: value, params: params}
+	if !e.custom {
+		e.tmpl = defaultMessages[key]
+	}
+	return e
+}
+
+// renderError return the message of the error, the default message of a built-in rule is rendered
+// using the template provided by templates if available
+func renderError(err error, templates templateFunc) string {
+	e, ok := err.(*messageError)
+	if !ok || e.custom || templates == nil {
+		return err.Error()
+	}
+	if tmpl, ok := templates(e.key); ok {
+		return renderMessage(tmpl, e.field, e.value, e.params...)
+	}
+	return e.Error()
+}
+
+// renderMessage replace the placeholders of the message template
+func renderMessage(tmpl, field string, value interface{}, params ...string) string {
+	if !strings.Contains(tmpl, "{") {
+		return tmpl
+	}
+	pairs := make([]string, 0, 6+len(params))
+	str := ""
+	if value != nil {
+		str = toString(value)
+	}
+	pairs = append(pairs, "{attribute}", field, "{value}", str, "{value_len}", valueLen(value))
+	for i := 0; i+1 < len(params); i += 2 {
+		pairs = append(pairs, "{"+params[i]+"}", params[i+1])
+	}
+	return strings.NewReplacer(pairs...).Replace(tmpl)
+}
+
+// valueLen return the length of string, array, slice or map value, empty for the other types
+func valueLen(value interface{}) string {
+	switch rv := reflect.ValueOf(value); rv.Kind() {
+	case reflect.String, reflect.Array, reflect.Slice, reflect.Map:
+		return strconv.Itoa(rv.Len())
+	}
+	return ""
+}
+
+// formatNumber return the shortest representation of the numeric rule argument e.g: -2000 for -2000.0
+// the argument is returned as is if it is not a number
+func formatNumber(arg string) string {
+	f, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return arg
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
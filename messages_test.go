@@ -0,0 +1,137 @@
+package govalidator
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+func Test_renderMessage(t *testing.T) {
+	list := map[string]struct {
+		tmpl     string
+		value    interface{}
+		params   []string
+		expected string
+	}{
+		"plain":    {"custom message", "john", nil, "custom message"},
+		"field":    {"The {attribute} field is required", nil, nil, "The name field is required"},
+		"value":    {"{value} is invalid, {value_len} chars", "john", nil, "john is invalid, 4 chars"},
+		"params":   {"between {min} and {max}", 5, []string{"min", "1", "max", "3"}, "between 1 and 3"},
+		"unknown":  {"{unknown} stays", "x", nil, "{unknown} stays"},
+		"no value": {"[{value}][{value_len}]", nil, nil, "[][]"},
+	}
+	for name, l := range list {
+		if msg := renderMessage(l.tmpl, "name", l.value, l.params...); msg != l.expected {
+			t.Errorf("renderMessage failed for %s: expected %q got %q", name, l.expected, msg)
+		}
+	}
+}
+
+func TestValidator_Attributes(t *testing.T) {
+	values := url.Values{
+		"username":    []string{"jo"},
+		"age":         []string{"10"},
+		"items.0.sku": []string{""},
+		"zip":         []string{"abc"},
+	}
+	opts := Options{
+		Rules: MapData{
+			"username":    []string{"between:3,8"},
+			"age":         []string{"integer", "min:18"},
+			"items.0.sku": []string{"required"},
+			"zip":         []string{"numeric"},
+		},
+		Messages: MapData{
+			"username": []string{"between:The {attribute} must be between {min} and {max} chars, got {value_len}"},
+			"zip":      []string{"numeric:{value} is not a valid {attribute}"},
+		},
+		Attributes: map[string]string{
+			"username":    "user name",
+			"age":         "Age",
+			"items.*.sku": "item SKU",
+			"zip":         "zip code",
+		},
+	}
+
+	validationErr := New(opts).ValidateValues(values)
+	expected := map[string]string{
+		"username":    "The user name must be between 3 and 8 chars, got 2",
+		"age":         "The Age field value can not be less than 18",
+		"items.0.sku": "The item SKU field is required",
+		"zip":         "abc is not a valid zip code",
+	}
+	if len(validationErr) != len(expected) {
+		t.Log(validationErr)
+		t.Error("ValidateValues failed to render the messages")
+	}
+	for field, msg := range expected {
+		if validationErr.Get(field) != msg {
+			t.Errorf("expected %q for %s, got %q", msg, field, validationErr.Get(field))
+		}
+	}
+}
+
+func TestValidator_SetDefaultMessage(t *testing.T) {
+	AddCustomRule("__even__", func(field string, rule string, message string, value interface{}) error {
+		if n, _ := strconv.Atoi(toString(value)); n%2 != 0 {
+			if message != "" {
+				return errors.New(message)
+			}
+			return fmt.Errorf("The %s field must be even", field)
+		}
+		return nil
+	})
+	SetDefaultMessage("__even__", "{attribute}: {value} is odd")
+	SetDefaultMessage("min.string", "{attribute} needs {min}+ chars")
+	defer func() {
+		delete(messageOverrides, "__even__")
+		delete(messageOverrides, "min.string")
+	}()
+
+	values := url.Values{
+		"email": []string{"john"},
+		"name":  []string{"jo"},
+		"count": []string{"3"},
+		"total": []string{"5"},
+		"age":   []string{"10"},
+	}
+	v := New(Options{
+		Rules: MapData{
+			"email": []string{"email"},
+			"name":  []string{"min:3"},
+			"count": []string{"__even__"},
+			"total": []string{"__even__"},
+			"age":   []string{"numeric", "min:18"},
+		},
+		Messages: MapData{
+			"total": []string{"__even__:total is odd"},
+		},
+		Locale: "bn",
+	})
+	v.SetDefaultMessage("email", "{attribute} is not an email.")
+
+	validationErr := v.ValidateValues(values)
+	expected := map[string]string{
+		"email": "email is not an email.",
+		"name":  "name needs 3+ chars",
+		"count": "count: 3 is odd",
+		"total": "total is odd",
+		"age":   "age ফিল্ডের মান 18 এর কম হতে পারবে না",
+	}
+	for field, msg := range expected {
+		if validationErr.Get(field) != msg {
+			t.Errorf("expected %q for %s, got %q", msg, field, validationErr.Get(field))
+		}
+	}
+}
+
+func Test_SetDefaultMessage_panic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("SetDefaultMessage with unknown rule did not panic")
+		}
+	}()
+	SetDefaultMessage("unknown_rule", "message")
+}
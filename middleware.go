@@ -0,0 +1,103 @@
+package govalidator
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+type (
+	// MiddlewareOption describes a function configuring the middleware
+	MiddlewareOption func(*middleware)
+
+	// middleware represents the configuration of the validation middleware
+	middleware struct {
+		schema       *Schema
+		newData      func() interface{}
+		opts         Options
+		renderer     Renderer
+		errorHandler func(r *http.Request, err error)
+	}
+
+	// dataCtxKey represents the context key of the validated data
+	dataCtxKey struct{}
+)
+
+// WithRenderer change the renderer writing the errors, default is RenderProblem
+func WithRenderer(renderer Renderer) MiddlewareOption {
+	return func(m *middleware) {
+		m.renderer = renderer
+	}
+}
+
+// WithErrorHandler set the function handling the error of the renderer e.g: to log it, the error is ignored by default
+func WithErrorHandler(handler func(r *http.Request, err error)) MiddlewareOption {
+	return func(m *middleware) {
+		m.errorHandler = handler
+	}
+}
+
+// WithOptions set the base options of the validators e.g: TagIdentifier, MaxBodyBytes or Locale
+// the request, the data, the rules and the messages are provided by the middleware
+func WithOptions(opts Options) MiddlewareOption {
+	return func(m *middleware) {
+		m.opts = opts
+	}
+}
+
+// Middleware return a net/http middleware validating the request using the current rules and messages of the schema
+// newData return a pointer to the struct the body is decoded into, the form values are bound like ValidateForm
+// if newData is nil the JSON body is decoded into a map, the form is validated like Validate and XML body is rejected
+// the errors are written by the renderer and the next handler is not called, the error of the renderer is passed to
+// WithErrorHandler, otherwise the validated data is available using DataFromContext
+// the request is not validated if the schema has no rules e.g: an empty config, DataFromContext returns nil
+func Middleware(schema *Schema, newData func() interface{}, opts ...MiddlewareOption) func(http.Handler) http.Handler {
+	m := &middleware{schema: schema, newData: newData, renderer: RenderProblem}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			data, errs := m.validate(r)
+			if len(errs) > 0 {
+				// the response is already started, the error can only be reported to the caller
+				if err := m.renderer(w, errs); err != nil && m.errorHandler != nil {
+					m.errorHandler(r, err)
+				}
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), dataCtxKey{}, data)))
+		})
+	}
+}
+
+// validate validate the request by the Content-Type and return the decoded data
+func (m *middleware) validate(r *http.Request) (interface{}, url.Values) {
+	// the data of the base options would be shared by the concurrent requests
+	opts := m.opts
+	opts.Request = r
+	opts.Data = nil
+	if m.newData != nil {
+		opts.Data = m.newData()
+	}
+	mediaType, err := parseMediaType(r.Header.Get("Content-Type"))
+	if err == nil && opts.Data == nil && !isFormMediaType(mediaType) && !isXMLMediaType(mediaType) {
+		opts.Data = &map[string]interface{}{}
+	}
+	v := m.schema.New(opts)
+	if !v.hasRules() {
+		return nil, nil
+	}
+	if err == nil && opts.Data != nil && isFormMediaType(mediaType) {
+		return v.Opts.Data, v.ValidateForm()
+	}
+	errs := v.ValidateRequest()
+	return v.Opts.Data, errs
+}
+
+// DataFromContext return the data validated by the middleware
+// it is a pointer returned by newData or a pointer to map[string]interface{} for JSON body
+// nil is returned for the form if newData is nil
+func DataFromContext(ctx context.Context) interface{} {
+	return ctx.Value(dataCtxKey{})
+}
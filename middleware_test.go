@@ -0,0 +1,94 @@
+package govalidator
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	type user struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+	schema := NewSchema(MapData{
+		"name": []string{"required"},
+		"age":  []string{"min:18"},
+	}, nil)
+	var validated interface{}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		validated = DataFromContext(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	})
+	handler := Middleware(schema, func() interface{} { return &user{} })(next)
+
+	serve := func(h http.Handler, contentType, body string) *httptest.ResponseRecorder {
+		validated = nil
+		req := httptest.NewRequest("POST", "/", strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w
+	}
+
+	w := serve(handler, "application/json", `{"name":"john","age":20}`)
+	if u, ok := validated.(*user); w.Code != http.StatusNoContent || !ok || u.Name != "john" || u.Age != 20 {
+		t.Errorf("Middleware failed to pass the validated data: %d %v", w.Code, validated)
+	}
+
+	w = serve(handler, "application/x-www-form-urlencoded", "name=jane&age=30")
+	if u, ok := validated.(*user); w.Code != http.StatusNoContent || !ok || u.Name != "jane" || u.Age != 30 {
+		t.Errorf("Middleware failed to bind the form: %d %v", w.Code, validated)
+	}
+
+	w = serve(handler, "application/json", `{"age":10}`)
+	if w.Code != http.StatusUnprocessableEntity || validated != nil ||
+		w.Header().Get("Content-Type") != "application/problem+json" || !bytes.Contains(w.Body.Bytes(), []byte("invalid-params")) {
+		t.Errorf("Middleware failed to write the errors: %d %s", w.Code, w.Body)
+	}
+
+	custom := Middleware(schema, nil, WithRenderer(func(w http.ResponseWriter, errs url.Values) error {
+		w.WriteHeader(http.StatusBadRequest)
+		_, err := w.Write([]byte(errs.Get("name")))
+		return err
+	}), WithOptions(Options{Locale: "bn"}))(next)
+	w = serve(custom, "application/json", `{"age":20}`)
+	if w.Code != http.StatusBadRequest || w.Body.String() != "name ফিল্ডটি আবশ্যক" {
+		t.Errorf("Middleware failed to use the renderer: %d %s", w.Code, w.Body)
+	}
+
+	w = serve(custom, "application/json", `{"name":"john"}`)
+	if m, ok := validated.(*map[string]interface{}); w.Code != http.StatusNoContent || !ok || (*m)["name"] != "john" {
+		t.Errorf("Middleware failed to decode into map: %d %v", w.Code, validated)
+	}
+
+	shared := map[string]interface{}{"name": "shared"}
+	withData := Middleware(schema, nil, WithOptions(Options{Data: &shared}))(next)
+	w = serve(withData, "application/json", `{"name":"john"}`)
+	if m, ok := validated.(*map[string]interface{}); w.Code != http.StatusNoContent || !ok || m == &shared || shared["name"] != "shared" {
+		t.Errorf("Middleware failed to ignore the data of the options: %d %v", w.Code, validated)
+	}
+
+	var renderErr error
+	failing := Middleware(schema, nil, WithRenderer(func(w http.ResponseWriter, errs url.Values) error {
+		return errors.New("broken pipe")
+	}), WithErrorHandler(func(r *http.Request, err error) {
+		renderErr = err
+	}))(next)
+	serve(failing, "application/json", `{}`)
+	if renderErr == nil || renderErr.Error() != "broken pipe" {
+		t.Errorf("Middleware failed to pass the error of the renderer: %v", renderErr)
+	}
+
+	rules, _ := LoadRules(strings.NewReader(""))
+	empty := Middleware(NewSchema(rules, nil), func() interface{} { return &user{} })(next)
+	for _, contentType := range []string{"application/json", "application/x-www-form-urlencoded"} {
+		if w = serve(empty, contentType, `{}`); w.Code != http.StatusNoContent || validated != nil {
+			t.Errorf("Middleware failed to skip the validation without rules for %s: %d %v", contentType, w.Code, validated)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	errorKey        = "_error" // errorKey represents the key of the errors not related to a field e.g: malformed body
+	maxTreeArrayLen = 10000    // maxTreeArrayLen represents the max length of an array in the error tree
+)
+
+type (
+	// Renderer describes a function writing the validation errors as response
+	Renderer func(w http.ResponseWriter, errs url.Values) error
+
+	// Problem represents the RFC 7807 problem details of the validation errors
+	Problem struct {
+		Type          string         `json:"type"`
+		Title         string         `json:"title"`
+		Status        int            `json:"status"`
+		Detail        string         `json:"detail,omitempty"`
+		InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+	}
+
+	// InvalidParam represents an error of a field in the problem details
+	InvalidParam struct {
+		Name   string `json:"name"`
+		Reason string `json:"reason"`
+	}
+
+	// JSONAPIErrors represents the JSON:API document of the validation errors
+	JSONAPIErrors struct {
+		Errors []JSONAPIError `json:"errors"`
+	}
+
+	// JSONAPIError represents an error object of the JSON:API document
+	JSONAPIError struct {
+		Status string         `json:"status"`
+		Title  string         `json:"title"`
+		Detail string         `json:"detail"`
+		Source *JSONAPISource `json:"source,omitempty"`
+	}
+
+	// JSONAPISource represents the source of the JSON:API error
+	JSONAPISource struct {
+		Pointer string `json:"pointer"`
+	}
+)
+
+// NewProblem return the RFC 7807 problem details of the validation errors
+// the errors of the fields are listed in invalid-params, the other errors are joined in detail
+func NewProblem(errs url.Values) Problem {
+	p := Problem{
+		Type:   "about:blank",
+		Title:  "Your request parameters didn't validate.",
+		Status: http.StatusUnprocessableEntity,
+	}
+	for _, field := range sortedKeys(errs) {
+		if field == errorKey {
+			p.Detail = strings.Join(errs[field], "; ")
+			continue
+		}
+		for _, msg := range errs[field] {
+			p.InvalidParams = append(p.InvalidParams, InvalidParam{Name: field, Reason: msg})
+		}
+	}
+	return p
+}
+
+// NewJSONAPIErrors return the JSON:API document of the validation errors
+// the source pointer of a field refers to the attribute of the primary data e.g: /data/attributes/items/0/sku
+// the pointer is built from the error key, the flat rule key of a nested value e.g: zip points to the top level
+func NewJSONAPIErrors(errs url.Values) JSONAPIErrors {
+	doc := JSONAPIErrors{Errors: []JSONAPIError{}}
+	status := strconv.Itoa(http.StatusUnprocessableEntity)
+	for _, field := range sortedKeys(errs) {
+		for _, msg := range errs[field] {
+			e := JSONAPIError{Status: status, Title: "Invalid Attribute", Detail: msg}
+			if field == errorKey {
+				e.Title = "Invalid Request"
+			} else {
+				e.Source = &JSONAPISource{Pointer: "/data/attributes" + jsonPointer(field)}
+			}
+			doc.Errors = append(doc.Errors, e)
+		}
+	}
+	return doc
+}
+
+// NewErrorTree return the validation errors nested by the dotted field names mirroring the shape of the payload
+// the numeric segments become arrays, the messages of a field having nested errors are kept in the _error key
+// e.g: {"items": [{"sku": ["The items.0.sku field is required"]}]}
+// like NewJSONAPIErrors only the dotted error keys are nested
+func NewErrorTree(errs url.Values) map[string]interface{} {
+	root := map[string]interface{}{}
+	for _, field := range sortedKeys(errs) {
+		node := root
+		segs := strings.Split(field, ".")
+		for _, seg := range segs[:len(segs)-1] {
+			switch child := node[seg].(type) {
+			case map[string]interface{}:
+				node = child
+			case []string:
+				node[seg] = map[string]interface{}{errorKey: child}
+				node = node[seg].(map[string]interface{})
+			default:
+				next := map[string]interface{}{}
+				node[seg] = next
+				node = next
+			}
+		}
+		last := segs[len(segs)-1]
+		if child, ok := node[last].(map[string]interface{}); ok {
+			child[errorKey] = errs[field]
+		} else {
+			node[last] = errs[field]
+		}
+	}
+	for k, v := range root {
+		root[k] = treeArrays(v)
+	}
+	return root
+}
+
+// treeArrays convert the nested nodes having only numeric keys to arrays
+// the nodes having an index larger than maxTreeArrayLen are kept as object
+func treeArrays(node interface{}) interface{} {
+	m, ok := node.(map[string]interface{})
+	if !ok {
+		return node
+	}
+	numeric, max := true, -1
+	for k, v := range m {
+		m[k] = treeArrays(v)
+		i, err := strconv.Atoi(k)
+		if err != nil || i < 0 || i >= maxTreeArrayLen {
+			numeric = false
+		} else if i > max {
+			max = i
+		}
+	}
+	if !numeric {
+		return m
+	}
+	arr := make([]interface{}, max+1)
+	for k, v := range m {
+		i, _ := strconv.Atoi(k)
+		arr[i] = v
+	}
+	return arr
+}
+
+// jsonPointer return the RFC 6901 JSON pointer of the dotted field name e.g: /items/0/sku
+func jsonPointer(field string) string {
+	r := strings.NewReplacer("~", "~0", "/", "~1")
+	var b strings.Builder
+	for _, seg := range strings.Split(field, ".") {
+		b.WriteString("/")
+		b.WriteString(r.Replace(seg))
+	}
+	return b.String()
+}
+
+// sortedKeys return the fields of the errors in order
+func sortedKeys(errs url.Values) []string {
+	keys := make([]string, 0, len(errs))
+	for k := range errs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// RenderProblem write the validation errors as application/problem+json with 422 status
+func RenderProblem(w http.ResponseWriter, errs url.Values) error {
+	return writeJSON(w, "application/problem+json", NewProblem(errs))
+}
+
+// RenderJSONAPI write the validation errors as JSON:API errors with 422 status
+func RenderJSONAPI(w http.ResponseWriter, errs url.Values) error {
+	return writeJSON(w, "application/vnd.api+json", NewJSONAPIErrors(errs))
+}
+
+// RenderTree write the validation errors nested by the field names as application/json with 422 status
+func RenderTree(w http.ResponseWriter, errs url.Values) error {
+	return writeJSON(w, "application/json", map[string]interface{}{"validationError": NewErrorTree(errs)})
+}
+
+// writeJSON write the value as JSON response with 422 status
+func writeJSON(w http.ResponseWriter, contentType string, v interface{}) error {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	return json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,103 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var renderErrs = url.Values{
+	"_error":      []string{"The request body is too large"},
+	"name":        []string{"The name field is required"},
+	"items":       []string{"The items field must be minimum 3 in size"},
+	"items.1.sku": []string{"The items.1.sku field is required"},
+	"a/b~c":       []string{"invalid"},
+}
+
+func TestRenderProblem(t *testing.T) {
+	w := httptest.NewRecorder()
+	if err := RenderProblem(w, renderErrs); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != 422 || w.Header().Get("Content-Type") != "application/problem+json" {
+		t.Errorf("RenderProblem failed to write the header: %d %s", w.Code, w.Header().Get("Content-Type"))
+	}
+	var p Problem
+	if err := json.Unmarshal(w.Body.Bytes(), &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Status != 422 || p.Detail != "The request body is too large" || len(p.InvalidParams) != 4 {
+		t.Errorf("RenderProblem failed: %s", w.Body)
+	}
+	if p.InvalidParams[3] != (InvalidParam{Name: "name", Reason: "The name field is required"}) {
+		t.Errorf("RenderProblem failed to order the params: %+v", p.InvalidParams)
+	}
+}
+
+func TestRenderJSONAPI(t *testing.T) {
+	w := httptest.NewRecorder()
+	if err := RenderJSONAPI(w, renderErrs); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != 422 || w.Header().Get("Content-Type") != "application/vnd.api+json" {
+		t.Errorf("RenderJSONAPI failed to write the header: %d %s", w.Code, w.Header().Get("Content-Type"))
+	}
+	var doc JSONAPIErrors
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	pointers := []string{}
+	for _, e := range doc.Errors {
+		if e.Status != "422" {
+			t.Errorf("RenderJSONAPI failed to set the status: %+v", e)
+		}
+		if e.Source != nil {
+			pointers = append(pointers, e.Source.Pointer)
+		}
+	}
+	expected := []string{"/data/attributes/a~1b~0c", "/data/attributes/items", "/data/attributes/items/1/sku", "/data/attributes/name"}
+	if len(doc.Errors) != 5 || !reflect.DeepEqual(pointers, expected) {
+		t.Errorf("RenderJSONAPI failed: %s", w.Body)
+	}
+}
+
+func TestNewJSONAPIErrors_nestedKeys(t *testing.T) {
+	r := httptest.NewRequest("POST", "/", strings.NewReader(`{"address": {"zip": "12", "city": 10}}`))
+	r.Header.Set("Content-Type", "application/json")
+	data := map[string]interface{}{}
+	errs := New(Options{Request: r, Data: &data, Rules: MapData{
+		"address.zip": []string{"len:4"},
+		"city":        []string{"string"},
+	}}).ValidateJSON()
+	pointers := []string{}
+	for _, e := range NewJSONAPIErrors(errs).Errors {
+		pointers = append(pointers, e.Source.Pointer)
+	}
+	// the dotted key keeps the path, the flat key points to the top level
+	expected := []string{"/data/attributes/address/zip", "/data/attributes/city"}
+	if !reflect.DeepEqual(pointers, expected) {
+		t.Errorf("NewJSONAPIErrors failed to point the nested keys: %v", pointers)
+	}
+}
+
+func TestRenderTree(t *testing.T) {
+	w := httptest.NewRecorder()
+	if err := RenderTree(w, renderErrs); err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"validationError":{"_error":["The request body is too large"],"a/b~c":["invalid"],` +
+		`"items":{"1":{"sku":["The items.1.sku field is required"]},"_error":["The items field must be minimum 3 in size"]},` +
+		`"name":["The name field is required"]}}` + "\n"
+	if w.Code != 422 || w.Body.String() != expected {
+		t.Errorf("RenderTree failed: %s", w.Body)
+	}
+
+	tree := NewErrorTree(url.Values{"items.1.sku": []string{"required"}, "items.0": []string{"invalid"}})
+	b, _ := json.Marshal(tree)
+	if string(b) != `{"items":[["invalid"],{"sku":["required"]}]}` {
+		t.Errorf("NewErrorTree failed to build arrays: %s", b)
+	}
+}
@@ -1,10 +1,15 @@
 package govalidator
 
 import (
+	"encoding/xml"
 	"reflect"
+	"strconv"
 	"strings"
 )
 
+// xmlNameType represents the type of XMLName field which holds the element name only
+var xmlNameType = reflect.TypeOf(xml.Name{})
+
 // ROADMAP
 // traverse map or struct
 // detect each type
@@ -15,17 +20,29 @@ import (
 
 // roller represents a roller type that will be used to flatten our data in a map[string]interface{}
 type roller struct {
-	root          map[string]interface{}
-	typeName      string
-	tagIdentifier string
-	tagSeparator  string
+	root           map[string]interface{}
+	typeName       string
+	tagIdentifier  string
+	tagSeparator   string
+	selfValidators []interface{}
+	parentTag      string                 // parentTag is the tag name of the struct being traversed, used for xml chardata
+	objects        map[string]interface{} // objects keep the nested maps, structs and null values by their key, used for the required and type rules
+	data           interface{}            // data represents the traversed value, its dotted paths are read on demand
+	paths          map[string]interface{} // paths keep the nested values by their dotted path e.g: address.city, items.0.sku
+	pathObjects    map[string]interface{} // pathObjects keep the nested maps, structs and null values by their dotted path
+	pathKeys       []string
 }
 
 // start start traversing through the tree
 func (r *roller) start(iface interface{}) {
 	//initialize the Tree
 	r.root = make(map[string]interface{})
+	r.objects = make(map[string]interface{})
 	r.typeName = ""
+	r.selfValidators = nil
+	r.parentTag = ""
+	r.data = iface
+	r.paths, r.pathObjects, r.pathKeys = nil, nil, nil
 	ifv := reflect.ValueOf(iface)
 	ift := reflect.TypeOf(iface)
 	if ift.Kind() == reflect.Ptr {
@@ -37,6 +54,7 @@ func (r *roller) start(iface interface{}) {
 	switch ift.Kind() {
 	case reflect.Struct:
 		if canInterface {
+			r.collectSelfValidator(iface)
 			r.traverseStruct(ifv.Interface())
 		}
 	case reflect.Map:
@@ -68,15 +86,144 @@ func (r *roller) getFlatMap() map[string]interface{} {
 }
 
 // getFlatVal return interface{} value if exist
+// the dotted key e.g: address.city is looked up by the path if it is not a flatten key
 func (r *roller) getFlatVal(key string) (interface{}, bool) {
 	var val interface{}
 	var ok bool
 	if val, ok = r.root[key]; ok {
 		return val, ok
 	}
+	if strings.Contains(key, ".") {
+		r.loadPaths()
+		val, ok = r.paths[key]
+	}
+	return val, ok
+}
+
+// getObjectVal return the flatten value or the nested map or struct of the key if exist
+// the nested values are validated by the required and the type rules only
+func (r *roller) getObjectVal(key string) (interface{}, bool) {
+	if val, ok := r.getFlatVal(key); ok {
+		return val, ok
+	}
+	if val, ok := r.objects[key]; ok {
+		return val, ok
+	}
+	val, ok := r.pathObjects[key]
 	return val, ok
 }
 
+// childSegments return the distinct segments after the prefix in the dotted paths, used to expand the wildcard keys
+func (r *roller) childSegments(prefix string) []string {
+	r.loadPaths()
+	return childSegments(r.pathKeys, prefix)
+}
+
+// loadPaths walk through the data recording the nested values by their dotted path on the first call
+func (r *roller) loadPaths() {
+	if r.paths != nil {
+		return
+	}
+	r.paths = make(map[string]interface{})
+	r.pathObjects = make(map[string]interface{})
+	if r.data != nil {
+		r.walkPaths("", reflect.ValueOf(r.data))
+	}
+	for k := range r.paths {
+		r.pathKeys = append(r.pathKeys, k)
+	}
+	for k := range r.pathObjects {
+		r.pathKeys = append(r.pathKeys, k)
+	}
+}
+
+// walkPaths record the value and its children by the dotted path, the struct fields are named by the tag
+// maps, structs and null values are recorded as objects, the arrays are recorded as values along with their elements
+func (r *roller) walkPaths(path string, rv reflect.Value) {
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			// null value of a map is kept for the null rule, nil pointer is missing like the flatten values
+			if rv.Kind() == reflect.Interface && path != "" {
+				r.pathObjects[path] = nil
+			}
+			return
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() || !rv.CanInterface() {
+		return
+	}
+	iface := rv.Interface()
+	if isValueWrapper(iface) || isTimeValue(iface) {
+		r.paths[path] = iface
+		return
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		if rv.Type() == xmlNameType {
+			return
+		}
+		if path != "" {
+			r.pathObjects[path] = iface
+		}
+		rt := rv.Type()
+		for i := 0; i < rt.NumField(); i++ {
+			sf := rt.Field(i)
+			tag := sf.Tag.Get(r.tagIdentifier)
+			name := strings.Split(r.getTagName(tag), ",")[0]
+			if name == "-" || (sf.PkgPath != "" && !sf.Anonymous) {
+				continue
+			}
+			if sf.Anonymous && name == "" {
+				// the fields of embedded struct are promoted
+				r.walkPaths(path, rv.Field(i))
+				continue
+			}
+			if name == "" {
+				name = sf.Name
+			}
+			r.walkPaths(joinPath(path, name), rv.Field(i))
+		}
+	case reflect.Map:
+		if path != "" {
+			r.pathObjects[path] = iface
+		}
+		if rv.Type().Key().Kind() != reflect.String {
+			return
+		}
+		for _, k := range rv.MapKeys() {
+			r.walkPaths(joinPath(path, k.String()), rv.MapIndex(k))
+		}
+	case reflect.Slice, reflect.Array:
+		if path != "" {
+			r.paths[path] = iface
+		}
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < rv.Len(); i++ {
+			r.walkPaths(joinPath(path, strconv.Itoa(i)), rv.Index(i))
+		}
+	default:
+		if path != "" {
+			r.paths[path] = iface
+		}
+	}
+}
+
+// getSelfValidators return the visited structs which implement Validatable or RulesProvider
+func (r *roller) getSelfValidators() []interface{} {
+	return r.selfValidators
+}
+
+// collectSelfValidator keep track of the visited structs which validate themselves
+func (r *roller) collectSelfValidator(iface interface{}) {
+	if sv, ok := asSelfValidator(iface); ok {
+		r.selfValidators = append(r.selfValidators, sv)
+	}
+}
+
 // push add value to map if key does not exist
 func (r *roller) push(key string, val interface{}) bool {
 	if _, ok := r.root[key]; ok {
@@ -104,32 +251,33 @@ func (r *roller) traverseStruct(iface interface{}) {
 		case reflect.Struct:
 			var typeName string
 			if len(rfv.Tag.Get(r.tagIdentifier)) > 0 {
-				tags := strings.Split(rfv.Tag.Get(r.tagIdentifier), r.tagSeparator)
-				if tags[0] != "-" {
-					typeName = tags[0]
+				tag := r.getTagName(rfv.Tag.Get(r.tagIdentifier))
+				if tag != "-" {
+					typeName = tag
 				}
 			} else {
 				typeName = rfv.Name
 			}
 			if v.CanInterface() {
-				switch v.Type().String() {
-				case "govalidator.Int":
-					r.push(typeName, v.Interface())
-				case "govalidator.Int64":
-					r.push(typeName, v.Interface())
-				case "govalidator.Float32":
+				if isValueWrapper(v.Interface()) || isTimeValue(v.Interface()) {
+					// time.Time, Nullable, sql.Null* and ValidationValuer are validated as a single value
 					r.push(typeName, v.Interface())
-				case "govalidator.Float64":
-					r.push(typeName, v.Interface())
-				case "govalidator.Bool":
-					r.push(typeName, v.Interface())
-				default:
+				} else if v.Type() != xmlNameType {
+					// XMLName field of xml body holds the element name only
 					r.typeName = ift.Name()
-					r.traverseStruct(v.Interface())
+					r.collectSelfValidator(v.Interface())
+					r.traverseChildStruct(typeName, v.Interface())
 				}
 			}
 		case reflect.Map:
 			if v.CanInterface() {
+				name := rfv.Name
+				if len(rfv.Tag.Get(r.tagIdentifier)) > 0 {
+					name = r.getTagName(rfv.Tag.Get(r.tagIdentifier))
+				}
+				if _, ok := r.objects[name]; !ok && name != "" && name != "-" {
+					r.objects[name] = v.Interface()
+				}
 				r.traverseMap(v.Interface())
 			}
 		case reflect.Ptr: // if the field inside struct is Ptr then get the type and underlying values as interface{}
@@ -138,22 +286,49 @@ func (r *roller) traverseStruct(iface interface{}) {
 				ptrField := ptrReflectionVal.Type()
 				switch ptrField.Kind() {
 				case reflect.Struct:
-					if v.CanInterface() {
-						r.traverseStruct(v.Interface())
+					// the untagged field is named like the non pointer struct field
+					typeName := rfv.Name
+					if len(rfv.Tag.Get(r.tagIdentifier)) > 0 {
+						if typeName = r.getTagName(rfv.Tag.Get(r.tagIdentifier)); typeName == "-" {
+							typeName = ""
+						}
+					}
+					if (isValueWrapper(ptrReflectionVal.Interface()) || isTimeValue(ptrReflectionVal.Interface())) && v.CanInterface() {
+						r.push(typeName, v.Interface())
+					} else if v.CanInterface() {
+						r.collectSelfValidator(v.Interface())
+						r.traverseChildStruct(typeName, v.Interface())
 					}
 				case reflect.Map:
 					if v.CanInterface() {
 						r.traverseMap(v.Interface())
 					}
+				default:
+					// pointer to primitive is pushed as is, the rules validate the underlying value
+					tag := ift.Name() + "." + rfv.Name
+					if len(rfv.Tag.Get(r.tagIdentifier)) > 0 {
+						tag = r.getTagName(rfv.Tag.Get(r.tagIdentifier))
+					}
+					if tag != "-" && tag != "" && v.CanInterface() {
+						r.push(tag, v.Interface())
+					}
 				}
 			}
 		default:
 			if len(rfv.Tag.Get(r.tagIdentifier)) > 0 {
-				tags := strings.Split(rfv.Tag.Get(r.tagIdentifier), r.tagSeparator)
+				tag := r.getTagName(rfv.Tag.Get(r.tagIdentifier))
+				if tag == "" && r.isXMLCharData(rfv.Tag.Get(r.tagIdentifier)) {
+					// chardata is the text of the parent element
+					tag = r.parentTag
+				}
+				if tag == "" && r.tagIdentifier == xmlTagIdentifier {
+					// xml tag having options only e.g: ",attr" use the field name like an untagged field
+					tag = ift.Name() + "." + rfv.Name
+				}
 				// add if first tag is not hyphen
-				if tags[0] != "-" {
+				if tag != "-" {
 					if v.CanInterface() {
-						r.push(tags[0], v.Interface())
+						r.push(tag, v.Interface())
 					}
 				}
 			} else {
@@ -171,25 +346,74 @@ func (r *roller) traverseStruct(iface interface{}) {
 	}
 }
 
+// traverseChildStruct traverse the nested struct keeping track of its tag name
+func (r *roller) traverseChildStruct(tag string, iface interface{}) {
+	if _, ok := r.objects[tag]; !ok && tag != "" {
+		r.objects[tag] = iface
+	}
+	parentTag := r.parentTag
+	r.parentTag = tag
+	r.traverseStruct(iface)
+	r.parentTag = parentTag
+}
+
+// getTagName return the field name from the struct tag
+// for xml tag the options like attr, omitempty are dropped and the last element of a>b path is used
+func (r *roller) getTagName(tag string) string {
+	name := strings.Split(tag, r.tagSeparator)[0]
+	if r.tagIdentifier == xmlTagIdentifier {
+		name = strings.Split(name, ",")[0]
+		if i := strings.LastIndex(name, ">"); i != -1 {
+			name = name[i+1:]
+		}
+	}
+	return name
+}
+
+// isXMLCharData check if the xml tag represents the character data of the element
+func (r *roller) isXMLCharData(tag string) bool {
+	if r.tagIdentifier != xmlTagIdentifier {
+		return false
+	}
+	for _, opt := range strings.Split(tag, ",")[1:] {
+		if opt == "chardata" {
+			return true
+		}
+	}
+	return false
+}
+
 // traverseMap through all the map and add it to root
 func (r *roller) traverseMap(iface interface{}) {
 	switch t := iface.(type) {
 	case map[string]interface{}:
 		for k, v := range t {
-			// drop null values in json to prevent panic caused by reflect.TypeOf(nil)
+			// drop null values in json to prevent panic caused by reflect.TypeOf(nil), they are kept for the null rule
 			if v == nil {
+				if _, ok := r.objects[k]; !ok {
+					r.objects[k] = nil
+				}
+				continue
+			}
+			if isValueWrapper(v) || isTimeValue(v) {
+				r.push(k, v)
 				continue
 			}
 			switch reflect.TypeOf(v).Kind() {
 			case reflect.Struct:
 				r.typeName = k // set the map key as name
+				r.collectSelfValidator(v)
 				r.traverseStruct(v)
 			case reflect.Map:
 				r.typeName = k // set the map key as name
+				if _, ok := r.objects[k]; !ok {
+					r.objects[k] = v
+				}
 				r.traverseMap(v)
 			case reflect.Ptr: // if the field inside map is Ptr then get the type and underlying values as interface{}
 				switch reflect.TypeOf(v).Elem().Kind() {
 				case reflect.Struct:
+					r.collectSelfValidator(v)
 					r.traverseStruct(v)
 				case reflect.Map:
 					switch mapType := v.(type) {
@@ -403,7 +403,7 @@ type structWithCustomType struct {
 
 func TestRoller_StartCustomType(t *testing.T) {
 	r := roller{}
-	swTag := structWithCustomType{Name: "John Doe", Integer: Int{Value: 44}}
+	swTag := structWithCustomType{Name: "John Doe", Integer: Int{V: 44, IsSet: true}}
 	r.setTagIdentifier("json")
 	r.setTagSeparator("|")
 	r.start(&swTag)
@@ -411,3 +411,37 @@ func TestRoller_StartCustomType(t *testing.T) {
 		t.Error("failed to push custom type")
 	}
 }
+
+func TestRoller_EmptyTagName(t *testing.T) {
+	type user struct {
+		Name string `validate:"|required"`
+		Age  int    `xml:",attr"`
+	}
+	r := roller{}
+	r.setTagIdentifier("validate")
+	r.setTagSeparator("|")
+	r.start(user{Name: "John"})
+	if name, _ := r.getFlatVal(""); name != "John" {
+		t.Error("StartStruct changed the key of empty tag name!")
+	}
+
+	r.setTagIdentifier("xml")
+	r.start(user{Age: 20})
+	if age, _ := r.getFlatVal("user.Age"); age != 20 {
+		t.Error("StartStruct failed to use the field name for xml tag options!")
+	}
+}
+
+func TestRoller_UntaggedPointerWrapper(t *testing.T) {
+	type user struct {
+		Age *Int
+	}
+	age := NewNullable(20)
+	r := roller{}
+	r.setTagIdentifier("json")
+	r.setTagSeparator("|")
+	r.start(user{Age: &age})
+	if val, ok := r.getFlatVal("Age"); !ok || val != &age {
+		t.Error("StartStruct failed to use the field name for untagged pointer wrapper!")
+	}
+}
@@ -1,6 +1,7 @@
 package govalidator
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
@@ -9,6 +10,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 )
 
 var rulesFuncMap = make(map[string]func(string, string, string, interface{}) error)
@@ -19,7 +21,7 @@ var rulesFuncMap = make(map[string]func(string, string, string, interface{}) err
 // fn func(name string, fn func(field string, rule string, message string, value interface{}) error
 // see example in readme: https://github.com/thedevsaddam/govalidator#add-custom-rules
 func AddCustomRule(name string, fn func(field string, rule string, message string, value interface{}) error) {
-	if isRuleExist(name) {
+	if isRuleExist(name) || name == "each" {
 		panic(fmt.Errorf("govalidator: %s is already defined in rules", name))
 	}
 	rulesFuncMap[name] = fn
@@ -27,126 +29,160 @@ func AddCustomRule(name string, fn func(field string, rule string, message strin
 
 // validateCustomRules validate custom rules
 func validateCustomRules(field string, rule string, message string, value interface{}, errsBag url.Values) {
+	validateRule(field, field, rule, message, value, errsBag, nil)
+}
+
+// validateRule validate the rule and add the error to the bag keyed by the field
+// attribute is the display name of the field passed to the rule for the messages
+// templates provide the localized default messages, nil means the default English messages
+func validateRule(field, attribute, rule, message string, value interface{}, errsBag url.Values, templates templateFunc) {
+	// required check the presence of the wrapper, the other rules validate the underlying value
+	if rule != "required" {
+		value = validationValue(value)
+	}
+	// json.Number is compared by its value except for the type rules
+	if n, ok := value.(json.Number); ok && !isIn(strictTypeRules, rule) {
+		value = jsonNumberValue(n)
+	}
 	for k, v := range rulesFuncMap {
 		if k == rule || strings.HasPrefix(rule, k+":") {
-			err := v(field, rule, message, value)
-			if err != nil {
-				errsBag.Add(field, err.Error())
+			err := v(attribute, rule, message, value)
+			if err == nil {
+				break
+			}
+			// the error of a custom rule is replaced by the default message of the rule if provided
+			if _, ok := err.(*messageError); !ok && message == "" && templates != nil {
+				if tmpl, ok := templates(k); ok {
+					err = errors.New(renderMessage(tmpl, attribute, value))
+				}
 			}
+			errsBag.Add(field, renderError(err, templates))
 			break
 		}
 	}
 }
 
+// numericTypeRules represents the type hint rules, min, max and between compare the numeric value
+// of a form field having any of them instead of the character length e.g: integer|min:18
+var numericTypeRules = []string{"integer", "number", "numeric", "float"}
+
+// numericSizeRules represents the rules honoring the numeric type hint
+var numericSizeRules = []string{"min", "max", "between"}
+
+// hasNumericHint check if the rules of the field contain a numeric type hint
+// each: prefixed hints are considered for the elements of a collection
+func hasNumericHint(rules []string) bool {
+	for _, rule := range rules {
+		if isIn(numericTypeRules, strings.TrimPrefix(rule, eachRulePrefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// strictTypeRules represents the rules checking the kind of the decoded value
+var strictTypeRules = []string{"string", "integer", "number", "array", "object", "boolean", "null"}
+
+// numericValue convert the form value to int or float64 if the rule compares size and the rules have a numeric type hint
+// or the rule is integer or number as every form value is a string
+// the value is returned as is if it is not a number so that the type rule reports it
+func numericValue(rules []string, rule, value string) interface{} {
+	name := strings.Split(rule, ":")[0]
+	if name != "integer" && name != "number" && (!isIn(numericSizeRules, name) || !hasNumericHint(rules)) {
+		return value
+	}
+	if i, err := strconv.Atoi(value); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return f
+	}
+	return value
+}
+
 func init() {
 
 	// Required check the Required fields
 	AddCustomRule("required", func(field, rule, message string, value interface{}) error {
-		err := fmt.Errorf("The %s field is required", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if value == nil {
-			return err
+			return ruleError("required", message, field, value)
 		}
 		if _, ok := value.(multipart.File); ok {
 			return nil
 		}
+		// non nil pointer and wrapper like Nullable or sql.NullString are considered as provided even if the value is zero
+		if isValueWrapper(value) {
+			if validationValue(value) == nil {
+				return ruleError("required", message, field, value)
+			}
+			return nil
+		}
 		rv := reflect.ValueOf(value)
 		switch rv.Kind() {
 		case reflect.String, reflect.Array, reflect.Slice, reflect.Map:
 			if rv.Len() == 0 {
-				return err
+				return ruleError("required", message, field, value)
 			}
 		case reflect.Int:
 			if isEmpty(value.(int)) {
-				return err
+				return ruleError("required", message, field, value)
 			}
 		case reflect.Int8:
 			if isEmpty(value.(int8)) {
-				return err
+				return ruleError("required", message, field, value)
 			}
 		case reflect.Int16:
 			if isEmpty(value.(int16)) {
-				return err
+				return ruleError("required", message, field, value)
 			}
 		case reflect.Int32:
 			if isEmpty(value.(int32)) {
-				return err
+				return ruleError("required", message, field, value)
 			}
 		case reflect.Int64:
 			if isEmpty(value.(int64)) {
-				return err
+				return ruleError("required", message, field, value)
 			}
 		case reflect.Float32:
 			if isEmpty(value.(float32)) {
-				return err
+				return ruleError("required", message, field, value)
 			}
 		case reflect.Float64:
 			if isEmpty(value.(float64)) {
-				return err
+				return ruleError("required", message, field, value)
 			}
 		case reflect.Uint:
 			if isEmpty(value.(uint)) {
-				return err
+				return ruleError("required", message, field, value)
 			}
 		case reflect.Uint8:
 			if isEmpty(value.(uint8)) {
-				return err
+				return ruleError("required", message, field, value)
 			}
 		case reflect.Uint16:
 			if isEmpty(value.(uint16)) {
-				return err
+				return ruleError("required", message, field, value)
 			}
 		case reflect.Uint32:
 			if isEmpty(value.(uint32)) {
-				return err
+				return ruleError("required", message, field, value)
 			}
 		case reflect.Uint64:
 			if isEmpty(value.(uint64)) {
-				return err
+				return ruleError("required", message, field, value)
 			}
 		case reflect.Uintptr:
 			if isEmpty(value.(uintptr)) {
-				return err
+				return ruleError("required", message, field, value)
 			}
+		case reflect.Bool:
+			// false is a value of the boolean field, use *bool or Bool to check if a struct field is provided
+			return nil
 		case reflect.Struct:
-			switch rv.Type().String() {
-			case "govalidator.Int":
-				if v, ok := value.(Int); ok {
-					if !v.IsSet {
-						return err
-					}
-				}
-			case "govalidator.Int64":
-				if v, ok := value.(Int64); ok {
-					if !v.IsSet {
-						return err
-					}
-				}
-			case "govalidator.Float32":
-				if v, ok := value.(Float32); ok {
-					if !v.IsSet {
-						return err
-					}
-				}
-			case "govalidator.Float64":
-				if v, ok := value.(Float64); ok {
-					if !v.IsSet {
-						return err
-					}
-				}
-			case "govalidator.Bool":
-				if v, ok := value.(Bool); ok {
-					if !v.IsSet {
-						return err
-					}
-				}
-			default:
-				panic("govalidator: invalid custom type for required rule")
-
+			// nested struct is considered as provided unless it is the zero value
+			if t, ok := value.(time.Time); (ok && t.IsZero()) || isEmpty(value) {
+				return ruleError("required", message, field, value)
 			}
-
 		default:
 			panic("govalidator: invalid type for required rule")
 
@@ -158,13 +194,9 @@ func init() {
 	// Regex:^[a-zA-Z]+$ means this field can only contain alphabet (a-z and A-Z)
 	AddCustomRule("regex", func(field, rule, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field format is invalid", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		rxStr := strings.TrimPrefix(rule, "regex:")
 		if !isMatchedRegex(rxStr, str) {
-			return err
+			return ruleError("regex", message, field, value)
 		}
 		return nil
 	})
@@ -172,12 +204,8 @@ func init() {
 	// Alpha check if provided field contains valid letters
 	AddCustomRule("alpha", func(field string, vlaue string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s may only contain letters", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isAlpha(str) {
-			return err
+			return ruleError("alpha", message, field, value)
 		}
 		return nil
 	})
@@ -185,12 +213,8 @@ func init() {
 	// AlphaDash check if provided field contains valid letters, numbers, underscore and dash
 	AddCustomRule("alpha_dash", func(field string, vlaue string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s may only contain letters, numbers, and dashes", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isAlphaDash(str) {
-			return err
+			return ruleError("alpha_dash", message, field, value)
 		}
 		return nil
 	})
@@ -198,12 +222,8 @@ func init() {
 	// AlphaDash check if provided field contains valid letters, numbers, underscore and dash
 	AddCustomRule("alpha_space", func(field string, vlaue string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s may only contain letters, numbers, dashes, space", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isAlphaSpace(str) {
-			return err
+			return ruleError("alpha_space", message, field, value)
 		}
 		return nil
 	})
@@ -211,12 +231,8 @@ func init() {
 	// AlphaNumeric check if provided field contains valid letters and numbers
 	AddCustomRule("alpha_num", func(field string, vlaue string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s may only contain letters and numbers", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isAlphaNumeric(str) {
-			return err
+			return ruleError("alpha_num", message, field, value)
 		}
 		return nil
 	})
@@ -224,60 +240,56 @@ func init() {
 	// Boolean check if provided field contains Boolean
 	// in this case: "0", "1", 0, 1, "true", "false", true, false etc
 	AddCustomRule("bool", func(field string, vlaue string, message string, value interface{}) error {
-		err := fmt.Errorf("The %s may only contain boolean value, string or int 0, 1", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		switch t := value.(type) {
 		case bool:
 			//if value is boolean then pass
 		case string:
 			if !isBoolean(t) {
-				return err
+				return ruleError("bool", message, field, value)
 			}
 		case int:
 			if t != 0 && t != 1 {
-				return err
+				return ruleError("bool", message, field, value)
 			}
 		case int8:
 			if t != 0 && t != 1 {
-				return err
+				return ruleError("bool", message, field, value)
 			}
 		case int16:
 			if t != 0 && t != 1 {
-				return err
+				return ruleError("bool", message, field, value)
 			}
 		case int32:
 			if t != 0 && t != 1 {
-				return err
+				return ruleError("bool", message, field, value)
 			}
 		case int64:
 			if t != 0 && t != 1 {
-				return err
+				return ruleError("bool", message, field, value)
 			}
 		case uint:
 			if t != 0 && t != 1 {
-				return err
+				return ruleError("bool", message, field, value)
 			}
 		case uint8:
 			if t != 0 && t != 1 {
-				return err
+				return ruleError("bool", message, field, value)
 			}
 		case uint16:
 			if t != 0 && t != 1 {
-				return err
+				return ruleError("bool", message, field, value)
 			}
 		case uint32:
 			if t != 0 && t != 1 {
-				return err
+				return ruleError("bool", message, field, value)
 			}
 		case uint64:
 			if t != 0 && t != 1 {
-				return err
+				return ruleError("bool", message, field, value)
 			}
 		case uintptr:
 			if t != 0 && t != 1 {
-				return err
+				return ruleError("bool", message, field, value)
 			}
 		}
 		return nil
@@ -291,6 +303,13 @@ func init() {
 		if len(rng) != 2 {
 			panic(errInvalidArgument)
 		}
+		// time is compared with the date arguments e.g: between:2020-01-01,2020-12-31
+		if t, ok := value.(time.Time); ok {
+			if t.Before(parseTimeArg(rng[0])) || t.After(parseTimeArg(rng[1])) {
+				return ruleError("between", message, field, value, "min", rng[0], "max", rng[1])
+			}
+			return nil
+		}
 		minFloat, err := strconv.ParseFloat(rng[0], 64)
 		if err != nil {
 			panic(errStringToInt)
@@ -303,10 +322,7 @@ func init() {
 
 		max := int(maxFloat)
 
-		err = fmt.Errorf("The %s field must be between %d and %d", field, min, max)
-		if message != "" {
-			err = errors.New(message)
-		}
+		err = ruleError("between", message, field, value, "min", rng[0], "max", rng[1])
 		rv := reflect.ValueOf(value)
 		switch rv.Kind() {
 		case reflect.String, reflect.Array, reflect.Map, reflect.Slice:
@@ -372,12 +388,12 @@ func init() {
 		case reflect.Float32:
 			in := float64(value.(float32))
 			if !(in >= minFloat && in <= maxFloat) {
-				return fmt.Errorf("The %s field must be between %f and %f", field, minFloat, maxFloat)
+				return err
 			}
 		case reflect.Float64:
 			in := value.(float64)
 			if !(in >= minFloat && in <= maxFloat) {
-				return fmt.Errorf("The %s field must be between %f and %f", field, minFloat, maxFloat)
+				return err
 			}
 
 		}
@@ -389,12 +405,8 @@ func init() {
 	// Accepted cards are Visa, MasterCard, American Express, Diners Club, Discover and JCB card
 	AddCustomRule("credit_card", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must be a valid credit card number", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isCreditCard(str) {
-			return err
+			return ruleError("credit_card", message, field, value)
 		}
 		return nil
 	})
@@ -402,12 +414,8 @@ func init() {
 	// Coordinate check if provided field contains valid Coordinate
 	AddCustomRule("coordinate", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must be a valid coordinate", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isCoordinate(str) {
-			return err
+			return ruleError("coordinate", message, field, value)
 		}
 		return nil
 	})
@@ -415,12 +423,8 @@ func init() {
 	// ValidateCSSColor check if provided field contains a valid CSS color code
 	AddCustomRule("css_color", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must be a valid CSS color code", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isCSSColor(str) {
-			return err
+			return ruleError("css_color", message, field, value)
 		}
 		return nil
 	})
@@ -433,12 +437,9 @@ func init() {
 		if err != nil {
 			panic(errStringToInt)
 		}
-		err = fmt.Errorf("The %s field must be %d digits", field, l)
+		key := "digits"
 		if l == 1 {
-			err = fmt.Errorf("The %s field must be 1 digit", field)
-		}
-		if message != "" {
-			err = errors.New(message)
+			key = "digits.one"
 		}
 		var str string
 		switch v := value.(type) {
@@ -452,7 +453,7 @@ func init() {
 			str = toString(v)
 		}
 		if len(str) != l || !regexDigits.MatchString(str) {
-			return err
+			return ruleError(key, message, field, value, "digits", strconv.Itoa(l))
 		}
 
 		return nil
@@ -473,13 +474,9 @@ func init() {
 		if err != nil {
 			panic(errStringToInt)
 		}
-		err = fmt.Errorf("The %s field must be digits between %d and %d", field, min, max)
-		if message != "" {
-			err = errors.New(message)
-		}
 		str := toString(value)
 		if !isNumeric(str) || !(len(str) >= min && len(str) <= max) {
-			return err
+			return ruleError("digits_between", message, field, value, "min", rng[0], "max", rng[1])
 		}
 
 		return nil
@@ -487,22 +484,19 @@ func init() {
 
 	// Date check the provided field is valid Date
 	AddCustomRule("date", func(field string, rule string, message string, value interface{}) error {
+		if _, ok := value.(time.Time); ok {
+			return nil
+		}
 		str := toString(value)
 
 		switch rule {
 		case "date:dd-mm-yyyy":
 			if !isDateDDMMYY(str) {
-				if message != "" {
-					return errors.New(message)
-				}
-				return fmt.Errorf("The %s field must be a valid date format. e.g: dd-mm-yyyy, dd/mm/yyyy etc", field)
+				return ruleError("date.dd-mm-yyyy", message, field, value)
 			}
 		default:
 			if !isDate(str) {
-				if message != "" {
-					return errors.New(message)
-				}
-				return fmt.Errorf("The %s field must be a valid date format. e.g: yyyy-mm-dd, yyyy/mm/dd etc", field)
+				return ruleError("date", message, field, value)
 			}
 		}
 
@@ -512,12 +506,8 @@ func init() {
 	// Email check the provided field is valid Email
 	AddCustomRule("email", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must be a valid email address", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isEmail(str) {
-			return err
+			return ruleError("email", message, field, value)
 		}
 		return nil
 	})
@@ -525,12 +515,8 @@ func init() {
 	// validFloat check the provided field is valid float number
 	AddCustomRule("float", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must be a float number", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isFloat(str) {
-			return err
+			return ruleError("float", message, field, value)
 		}
 		return nil
 	})
@@ -538,12 +524,8 @@ func init() {
 	// IP check if provided field is valid IP address
 	AddCustomRule("ip", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must be a valid IP address", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isIP(str) {
-			return err
+			return ruleError("ip", message, field, value)
 		}
 		return nil
 	})
@@ -551,12 +533,8 @@ func init() {
 	// IP check if provided field is valid IP v4 address
 	AddCustomRule("ip_v4", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must be a valid IPv4 address", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isIPV4(str) {
-			return err
+			return ruleError("ip_v4", message, field, value)
 		}
 		return nil
 	})
@@ -564,12 +542,8 @@ func init() {
 	// IP check if provided field is valid IP v6 address
 	AddCustomRule("ip_v6", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must be a valid IPv6 address", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isIPV6(str) {
-			return err
+			return ruleError("ip_v6", message, field, value)
 		}
 		return nil
 	})
@@ -577,12 +551,8 @@ func init() {
 	// ValidateJSON check if provided field contains valid json string
 	AddCustomRule("json", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must contain valid JSON string", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isJSON(str) {
-			return err
+			return ruleError("json", message, field, value)
 		}
 		return nil
 	})
@@ -590,12 +560,8 @@ func init() {
 	/// Latitude check if provided field contains valid Latitude
 	AddCustomRule("lat", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must contain valid latitude", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isLatitude(str) {
-			return err
+			return ruleError("lat", message, field, value)
 		}
 		return nil
 	})
@@ -603,12 +569,8 @@ func init() {
 	// Longitude check if provided field contains valid Longitude
 	AddCustomRule("lon", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must contain valid longitude", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isLongitude(str) {
-			return err
+			return ruleError("lon", message, field, value)
 		}
 		return nil
 	})
@@ -619,21 +581,17 @@ func init() {
 		if err != nil {
 			panic(errStringToInt)
 		}
-		err = fmt.Errorf("The %s field must be length of %d", field, l)
-		if message != "" {
-			err = errors.New(message)
-		}
 		rv := reflect.ValueOf(value)
 		switch rv.Kind() {
 		case reflect.String, reflect.Array, reflect.Map, reflect.Slice:
 			vLen := rv.Len()
 			if vLen != l {
-				return err
+				return ruleError("len", message, field, value, "len", strconv.Itoa(l))
 			}
 		default:
 			str := toString(value) //force the value to be string
 			if len(str) != l {
-				return err
+				return ruleError("len", message, field, value, "len", strconv.Itoa(l))
 			}
 		}
 
@@ -651,96 +609,82 @@ func init() {
 		if err != nil {
 			panic(errStringToFloat)
 		}
-		errMsg := fmt.Errorf("The %s field value can not be less than %d", field, lenInt)
-		if message != "" {
-			errMsg = errors.New(message)
-		}
-		errMsgFloat := fmt.Errorf("The %s field value can not be less than %f", field, lenFloat)
-		if message != "" {
-			errMsgFloat = errors.New(message)
-		}
 		rv := reflect.ValueOf(value)
 		switch rv.Kind() {
 		case reflect.String:
 			inLen := rv.Len()
 			if inLen < lenInt {
-				if message != "" {
-					return errors.New(message)
-				}
-				return fmt.Errorf("The %s field must be minimum %d char", field, lenInt)
+				return ruleError("min.string", message, field, value, "min", mustLen)
 			}
 		case reflect.Array, reflect.Map, reflect.Slice:
 			inLen := rv.Len()
 			if inLen < lenInt {
-				if message != "" {
-					return errors.New(message)
-				}
-				return fmt.Errorf("The %s field must be minimum %d in size", field, lenInt)
+				return ruleError("min.array", message, field, value, "min", mustLen)
 			}
 		case reflect.Int:
 			in := value.(int)
 			if in < lenInt {
-				return errMsg
+				return ruleError("min.numeric", message, field, value, "min", mustLen)
 			}
 		case reflect.Int8:
 			in := int(value.(int8))
 			if in < lenInt {
-				return errMsg
+				return ruleError("min.numeric", message, field, value, "min", mustLen)
 			}
 		case reflect.Int16:
 			in := int(value.(int16))
 			if in < lenInt {
-				return errMsg
+				return ruleError("min.numeric", message, field, value, "min", mustLen)
 			}
 		case reflect.Int32:
 			in := int(value.(int32))
 			if in < lenInt {
-				return errMsg
+				return ruleError("min.numeric", message, field, value, "min", mustLen)
 			}
 		case reflect.Int64:
 			in := int(value.(int64))
 			if in < lenInt {
-				return errMsg
+				return ruleError("min.numeric", message, field, value, "min", mustLen)
 			}
 		case reflect.Uint:
 			in := int(value.(uint))
 			if in < lenInt {
-				return errMsg
+				return ruleError("min.numeric", message, field, value, "min", mustLen)
 			}
 		case reflect.Uint8:
 			in := int(value.(uint8))
 			if in < lenInt {
-				return errMsg
+				return ruleError("min.numeric", message, field, value, "min", mustLen)
 			}
 		case reflect.Uint16:
 			in := int(value.(uint16))
 			if in < lenInt {
-				return errMsg
+				return ruleError("min.numeric", message, field, value, "min", mustLen)
 			}
 		case reflect.Uint32:
 			in := int(value.(uint32))
 			if in < lenInt {
-				return errMsg
+				return ruleError("min.numeric", message, field, value, "min", mustLen)
 			}
 		case reflect.Uint64:
 			in := int(value.(uint64))
 			if in < lenInt {
-				return errMsg
+				return ruleError("min.numeric", message, field, value, "min", mustLen)
 			}
 		case reflect.Uintptr:
 			in := int(value.(uintptr))
 			if in < lenInt {
-				return errMsg
+				return ruleError("min.numeric", message, field, value, "min", mustLen)
 			}
 		case reflect.Float32:
 			in := value.(float32)
 			if in < float32(lenFloat) {
-				return errMsgFloat
+				return ruleError("min.numeric", message, field, value, "min", mustLen)
 			}
 		case reflect.Float64:
 			in := value.(float64)
 			if in < lenFloat {
-				return errMsgFloat
+				return ruleError("min.numeric", message, field, value, "min", mustLen)
 			}
 
 		}
@@ -759,96 +703,82 @@ func init() {
 		if err != nil {
 			panic(errStringToFloat)
 		}
-		errMsg := fmt.Errorf("The %s field value can not be greater than %d", field, lenInt)
-		if message != "" {
-			errMsg = errors.New(message)
-		}
-		errMsgFloat := fmt.Errorf("The %s field value can not be greater than %f", field, lenFloat)
-		if message != "" {
-			errMsgFloat = errors.New(message)
-		}
 		rv := reflect.ValueOf(value)
 		switch rv.Kind() {
 		case reflect.String:
 			inLen := rv.Len()
 			if inLen > lenInt {
-				if message != "" {
-					return errors.New(message)
-				}
-				return fmt.Errorf("The %s field must be maximum %d char", field, lenInt)
+				return ruleError("max.string", message, field, value, "max", mustLen)
 			}
 		case reflect.Array, reflect.Map, reflect.Slice:
 			inLen := rv.Len()
 			if inLen > lenInt {
-				if message != "" {
-					return errors.New(message)
-				}
-				return fmt.Errorf("The %s field must be maximum %d in size", field, lenInt)
+				return ruleError("max.array", message, field, value, "max", mustLen)
 			}
 		case reflect.Int:
 			in := value.(int)
 			if in > lenInt {
-				return errMsg
+				return ruleError("max.numeric", message, field, value, "max", mustLen)
 			}
 		case reflect.Int8:
 			in := int(value.(int8))
 			if in > lenInt {
-				return errMsg
+				return ruleError("max.numeric", message, field, value, "max", mustLen)
 			}
 		case reflect.Int16:
 			in := int(value.(int16))
 			if in > lenInt {
-				return errMsg
+				return ruleError("max.numeric", message, field, value, "max", mustLen)
 			}
 		case reflect.Int32:
 			in := int(value.(int32))
 			if in > lenInt {
-				return errMsg
+				return ruleError("max.numeric", message, field, value, "max", mustLen)
 			}
 		case reflect.Int64:
 			in := int(value.(int64))
 			if in > lenInt {
-				return errMsg
+				return ruleError("max.numeric", message, field, value, "max", mustLen)
 			}
 		case reflect.Uint:
 			in := int(value.(uint))
 			if in > lenInt {
-				return errMsg
+				return ruleError("max.numeric", message, field, value, "max", mustLen)
 			}
 		case reflect.Uint8:
 			in := int(value.(uint8))
 			if in > lenInt {
-				return errMsg
+				return ruleError("max.numeric", message, field, value, "max", mustLen)
 			}
 		case reflect.Uint16:
 			in := int(value.(uint16))
 			if in > lenInt {
-				return errMsg
+				return ruleError("max.numeric", message, field, value, "max", mustLen)
 			}
 		case reflect.Uint32:
 			in := int(value.(uint32))
 			if in > lenInt {
-				return errMsg
+				return ruleError("max.numeric", message, field, value, "max", mustLen)
 			}
 		case reflect.Uint64:
 			in := int(value.(uint64))
 			if in > lenInt {
-				return errMsg
+				return ruleError("max.numeric", message, field, value, "max", mustLen)
 			}
 		case reflect.Uintptr:
 			in := int(value.(uintptr))
 			if in > lenInt {
-				return errMsg
+				return ruleError("max.numeric", message, field, value, "max", mustLen)
 			}
 		case reflect.Float32:
 			in := value.(float32)
 			if in > float32(lenFloat) {
-				return errMsgFloat
+				return ruleError("max.numeric", message, field, value, "max", mustLen)
 			}
 		case reflect.Float64:
 			in := value.(float64)
 			if in > lenFloat {
-				return errMsgFloat
+				return ruleError("max.numeric", message, field, value, "max", mustLen)
 			}
 
 		}
@@ -859,12 +789,8 @@ func init() {
 	// Numeric check if the value of the field is Numeric
 	AddCustomRule("mac_address", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must be a valid Mac Address", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isMacAddress(str) {
-			return err
+			return ruleError("mac_address", message, field, value)
 		}
 		return nil
 	})
@@ -872,12 +798,17 @@ func init() {
 	// Numeric check if the value of the field is Numeric
 	AddCustomRule("numeric", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must be numeric", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isNumeric(str) {
-			return err
+			return ruleError("numeric", message, field, value)
+		}
+		return nil
+	})
+
+	// Integer check if the decoded value of the field is an integer
+	// e.g: 18 or 18.0 of a JSON body but not "18", form values are converted before the check
+	AddCustomRule("integer", func(field string, rule string, message string, value interface{}) error {
+		if !isIntegerValue(value) {
+			return ruleError("integer", message, field, value)
 		}
 		return nil
 	})
@@ -914,28 +845,20 @@ func init() {
 			max = int(_max)
 		}
 
-		var errMsg error
+		key := "numeric_between"
 		switch {
 		case rng[0] == "":
-			errMsg = fmt.Errorf("The %s field value can not be greater than %d", field, max)
+			key = "numeric_between.max"
 		case rng[1] == "":
-			errMsg = fmt.Errorf("The %s field value can not be less than %d", field, min)
-		default:
-			errMsg = fmt.Errorf("The %s field must be numeric value between %d and %d", field, min, max)
-		}
-
-		if message != "" {
-			errMsg = errors.New(message)
+			key = "numeric_between.min"
 		}
+		errMsg := ruleError(key, message, field, value, "min", formatNumber(rng[0]), "max", formatNumber(rng[1]))
 
 		val := toString(value)
 
+		// the integer value is compared as integer, the fractional value is compared as float below
 		if !strings.Contains(rng[0], ".") || !strings.Contains(rng[1], ".") {
-			digit, errs := strconv.Atoi(val)
-			if errs != nil {
-				return errMsg
-			}
-			if !(digit >= min && digit <= max) {
+			if digit, errs := strconv.Atoi(val); errs == nil && !(digit >= min && digit <= max) {
 				return errMsg
 			}
 		}
@@ -957,19 +880,6 @@ func init() {
 			}
 		}
 
-		switch {
-		case rng[0] == "":
-			errMsg = fmt.Errorf("The %s field value can not be greater than %f", field, maxFloat)
-		case rng[1] == "":
-			errMsg = fmt.Errorf("The %s field value can not be less than %f", field, minFloat)
-		default:
-			errMsg = fmt.Errorf("The %s field must be numeric value between %f and %f", field, minFloat, maxFloat)
-		}
-
-		if message != "" {
-			errMsg = errors.New(message)
-		}
-
 		digit, err := strconv.ParseFloat(val, 64)
 		if err != nil {
 			return errMsg
@@ -983,12 +893,8 @@ func init() {
 	// ValidateURL check if provided field is valid URL
 	AddCustomRule("url", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field format is invalid", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isURL(str) {
-			return err
+			return ruleError("url", message, field, value)
 		}
 		return nil
 	})
@@ -996,12 +902,8 @@ func init() {
 	// UUID check if provided field contains valid UUID
 	AddCustomRule("uuid", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must contain valid UUID", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isUUID(str) {
-			return err
+			return ruleError("uuid", message, field, value)
 		}
 		return nil
 	})
@@ -1009,12 +911,8 @@ func init() {
 	// UUID3 check if provided field contains valid UUID of version 3
 	AddCustomRule("uuid_v3", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must contain valid UUID V3", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isUUID3(str) {
-			return err
+			return ruleError("uuid_v3", message, field, value)
 		}
 		return nil
 	})
@@ -1022,12 +920,8 @@ func init() {
 	// UUID4 check if provided field contains valid UUID of version 4
 	AddCustomRule("uuid_v4", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must contain valid UUID V4", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isUUID4(str) {
-			return err
+			return ruleError("uuid_v4", message, field, value)
 		}
 		return nil
 	})
@@ -1035,12 +929,8 @@ func init() {
 	// UUID5 check if provided field contains valid UUID of version 5
 	AddCustomRule("uuid_v5", func(field string, rule string, message string, value interface{}) error {
 		str := toString(value)
-		err := fmt.Errorf("The %s field must contain valid UUID V5", field)
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isUUID5(str) {
-			return err
+			return ruleError("uuid_v5", message, field, value)
 		}
 		return nil
 	})
@@ -1052,12 +942,8 @@ func init() {
 			panic(errInvalidArgument)
 		}
 		str := toString(value)
-		err := fmt.Errorf("The %s field must be one of %v", field, strings.Join(rng, ", "))
-		if message != "" {
-			err = errors.New(message)
-		}
 		if !isIn(rng, str) {
-			return err
+			return ruleError("in", message, field, value, "values", strings.Join(rng, ", "))
 		}
 		return nil
 	})
@@ -1069,12 +955,82 @@ func init() {
 			panic(errInvalidArgument)
 		}
 		str := toString(value)
-		err := fmt.Errorf("The %s field must not be any of %v", field, strings.Join(rng, ", "))
-		if message != "" {
-			err = errors.New(message)
-		}
 		if isIn(rng, str) {
-			return err
+			return ruleError("not_in", message, field, value, "values", strings.Join(rng, ", "))
+		}
+		return nil
+	})
+
+	// String check if the decoded value of the field is a string
+	AddCustomRule("string", func(field string, rule string, message string, value interface{}) error {
+		if _, ok := value.(json.Number); ok || reflect.ValueOf(value).Kind() != reflect.String {
+			return ruleError("string", message, field, value)
+		}
+		return nil
+	})
+
+	// Number check if the decoded value of the field is an integer or float number
+	AddCustomRule("number", func(field string, rule string, message string, value interface{}) error {
+		if !isNumberValue(value) {
+			return ruleError("number", message, field, value)
+		}
+		return nil
+	})
+
+	// Array check if the decoded value of the field is an array or slice
+	AddCustomRule("array", func(field string, rule string, message string, value interface{}) error {
+		switch reflect.ValueOf(value).Kind() {
+		case reflect.Array, reflect.Slice:
+			return nil
+		}
+		return ruleError("array", message, field, value)
+	})
+
+	// Object check if the decoded value of the field is an object e.g: map or struct
+	AddCustomRule("object", func(field string, rule string, message string, value interface{}) error {
+		switch reflect.Indirect(reflect.ValueOf(value)).Kind() {
+		case reflect.Map, reflect.Struct:
+			return nil
+		}
+		return ruleError("object", message, field, value)
+	})
+
+	// Boolean check if the decoded value of the field is true or false, unlike bool the strings are not accepted
+	AddCustomRule("boolean", func(field string, rule string, message string, value interface{}) error {
+		if reflect.ValueOf(value).Kind() != reflect.Bool {
+			return ruleError("boolean", message, field, value)
+		}
+		return nil
+	})
+
+	// Null check if the decoded value of the field is null
+	AddCustomRule("null", func(field string, rule string, message string, value interface{}) error {
+		if value == nil {
+			return nil
+		}
+		if rv := reflect.ValueOf(value); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return nil
+		}
+		return ruleError("null", message, field, value)
+	})
+	// Before check if the date of the field is before the date argument
+	// e.g: before:2020-01-01 or before:today, the value can be time.Time or a date string
+	AddCustomRule("before", func(field string, rule string, message string, value interface{}) error {
+		arg := strings.TrimPrefix(rule, "before:")
+		t, ok := parseTime(value)
+		if !ok || !t.Before(parseTimeArg(arg)) {
+			return ruleError("before", message, field, value, "date", arg)
+		}
+		return nil
+	})
+
+	// After check if the date of the field is after the date argument
+	// e.g: after:2020-01-01 or after:now, the value can be time.Time or a date string
+	AddCustomRule("after", func(field string, rule string, message string, value interface{}) error {
+		arg := strings.TrimPrefix(rule, "after:")
+		t, ok := parseTime(value)
+		if !ok || !t.After(parseTimeArg(arg)) {
+			return ruleError("after", message, field, value, "date", arg)
 		}
 		return nil
 	})
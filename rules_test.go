@@ -43,7 +43,7 @@ func Test_validateExtraRules(t *testing.T) {
 	}
 }
 
-//================================= rules =================================
+// ================================= rules =================================
 func Test_Required(t *testing.T) {
 	type tRequired struct {
 		Str       string      `json:"_str"`
@@ -1534,6 +1534,23 @@ func Test_NumericBetween(t *testing.T) {
 	}
 }
 
+func Test_NumericBetween_Fraction(t *testing.T) {
+	rules := MapData{"price": []string{"numeric_between:0,10"}}
+	list := map[string]bool{"5.5": true, "10": true, "10.5": false, "-0.5": false, "abc": false}
+	for price, valid := range list {
+		errs := New(Options{Rules: rules}).ValidateValues(url.Values{"price": []string{price}})
+		if (len(errs) == 0) != valid {
+			t.Errorf("numeric_between failed for %s: %v", price, errs)
+		}
+	}
+
+	req, _ := http.NewRequest("POST", "http://www.example.com", bytes.NewReader([]byte(`{"price":5.5}`)))
+	data := map[string]interface{}{}
+	if errs := New(Options{Request: req, Data: &data, Rules: rules}).ValidateJSON(); len(errs) != 0 {
+		t.Errorf("numeric_between failed for JSON fraction: %v", errs)
+	}
+}
+
 func Test_NumericBetween_invalid(t *testing.T) {
 	req, _ := http.NewRequest("GET", "/?field=1", bytes.NewReader([]byte{}))
 	validate := func(argument string) {
@@ -1550,6 +1567,75 @@ func Test_NumericBetween_invalid(t *testing.T) {
 	assertPanicWith(t, errInvalidArgument, func() { validate("numeric_between:,") })
 }
 
+func Test_Integer(t *testing.T) {
+	values := url.Values{}
+	values.Add("age", "30")
+	values.Add("count", "-5")
+	values.Add("score", "4.5")
+	values.Add("name", "abc")
+
+	rules := MapData{
+		"age":   []string{"integer"},
+		"count": []string{"integer"},
+		"score": []string{"integer"},
+		"name":  []string{"integer"},
+	}
+	messages := MapData{
+		"name": []string{"integer:custom_message"},
+	}
+
+	validationErr := New(Options{Rules: rules, Messages: messages}).ValidateValues(values)
+	if len(validationErr) != 2 {
+		t.Log(validationErr)
+		t.Error("Integer validation failed!")
+	}
+	if validationErr.Get("score") != "The score field must be an integer" {
+		t.Error("Integer message failed!")
+	}
+	if validationErr.Get("name") != "custom_message" {
+		t.Error("Integer custom message failed!")
+	}
+}
+
+func Test_NumericHint(t *testing.T) {
+	values := url.Values{}
+	values.Add("age", "9")
+	values.Add("price", "99.5")
+	values.Add("qty", "120")
+	values.Add("code", "12345")
+	values.Add("nick", "abc")
+	values.Add("ids[]", "1")
+	values.Add("ids[]", "25")
+
+	rules := MapData{
+		"age":   []string{"integer", "min:18"},
+		"price": []string{"float", "max:50"},
+		"qty":   []string{"numeric", "between:1,100"},
+		"code":  []string{"max:3"},
+		"nick":  []string{"integer", "min:5"},
+		"ids.*": []string{"integer", "max:10"},
+	}
+
+	validationErr := New(Options{Rules: rules}).ValidateValues(values)
+	expected := map[string]string{
+		"age":   "The age field value can not be less than 18",
+		"price": "The price field value can not be greater than 50",
+		"qty":   "The qty field must be between 1 and 100",
+		"code":  "The code field must be maximum 3 char",
+		"nick":  "The nick field must be an integer",
+		"ids.1": "The ids.1 field value can not be greater than 10",
+	}
+	if len(validationErr) != len(expected) {
+		t.Log(validationErr)
+		t.Error("Numeric type hint failed!")
+	}
+	for field, msg := range expected {
+		if validationErr.Get(field) != msg {
+			t.Errorf("expected %q for %s, got %q", msg, field, validationErr[field])
+		}
+	}
+}
+
 func assertPanicWith(t *testing.T, expectedError error, executer func()) {
 	defer func() {
 		r := recover()
@@ -2191,3 +2277,171 @@ func Test_NotIn_string_valid(t *testing.T) {
 		t.Error("not_in validation was triggered when valid!")
 	}
 }
+
+func Test_TypeRules(t *testing.T) {
+	body := `{"name":123,"age":"18","count":18,"score":4.5,"ratio":"4.5","tags":"go","address":{"city":"Dhaka"},` +
+		`"meta":"x","active":"true","enabled":false,"deleted":"no","items":[1,2]}`
+	req, _ := http.NewRequest("POST", "http://www.example.com", bytes.NewReader([]byte(body)))
+
+	rules := MapData{
+		"name":    []string{"string"},
+		"age":     []string{"integer"},
+		"count":   []string{"integer"},
+		"score":   []string{"number"},
+		"ratio":   []string{"number"},
+		"tags":    []string{"array"},
+		"items":   []string{"array"},
+		"address": []string{"object"},
+		"meta":    []string{"object"},
+		"active":  []string{"boolean"},
+		"enabled": []string{"boolean"},
+		"deleted": []string{"null"},
+	}
+	messages := MapData{
+		"meta": []string{"object:custom_message"},
+	}
+
+	data := map[string]interface{}{}
+	opts := Options{
+		Request:  req,
+		Data:     &data,
+		Rules:    rules,
+		Messages: messages,
+	}
+
+	validationErr := New(opts).ValidateJSON()
+	expected := map[string]string{
+		"name":    "The name field must be a string",
+		"age":     "The age field must be an integer",
+		"ratio":   "The ratio field must be a number",
+		"tags":    "The tags field must be an array",
+		"meta":    "custom_message",
+		"active":  "The active field must be a boolean",
+		"deleted": "The deleted field must be null",
+	}
+	if len(validationErr) != len(expected) {
+		t.Log(validationErr)
+		t.Error("Type rules validation failed!")
+	}
+	for field, msg := range expected {
+		if validationErr.Get(field) != msg {
+			t.Errorf("expected %q for %s, got %q", msg, field, validationErr.Get(field))
+		}
+	}
+}
+
+func Test_TypeRules_UseNumber(t *testing.T) {
+	body := `{"id":9007199254740993,"price":"10","qty":150}`
+	req, _ := http.NewRequest("POST", "http://www.example.com", bytes.NewReader([]byte(body)))
+
+	data := map[string]interface{}{}
+	opts := Options{
+		Request: req,
+		Data:    &data,
+		Rules: MapData{
+			"id":    []string{"integer"},
+			"price": []string{"number"},
+			"qty":   []string{"integer", "max:100"},
+		},
+		UseNumber: true,
+	}
+
+	validationErr := New(opts).ValidateJSON()
+	if len(validationErr) != 2 || validationErr.Get("price") == "" || validationErr.Get("qty") == "" {
+		t.Log(validationErr)
+		t.Error("Type rules with UseNumber failed!")
+	}
+	if id, ok := data["id"].(json.Number); !ok || id.String() != "9007199254740993" {
+		t.Error("UseNumber failed to keep the precision")
+	}
+}
+
+func Test_IntegerFormValue(t *testing.T) {
+	values := url.Values{}
+	values.Add("age", "18")
+	values.Add("score", "4.5")
+
+	rules := MapData{
+		"age":   []string{"integer"},
+		"score": []string{"number"},
+	}
+	validationErr := New(Options{Rules: rules}).ValidateValues(values)
+	if len(validationErr) != 0 {
+		t.Log(validationErr)
+		t.Error("Type rules failed for form values!")
+	}
+}
+
+func Test_TypeRules_Null(t *testing.T) {
+	data := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(`{"deleted":null,"removed":"","hidden":0,"name":"John"}`), &data); err != nil {
+		t.Fatal(err)
+	}
+	rules := MapData{
+		"deleted": []string{"null"},
+		"removed": []string{"null"},
+		"hidden":  []string{"null"},
+		"missing": []string{"null"},
+		"name":    []string{"required"},
+	}
+
+	validationErr := New(Options{Rules: rules}).ValidateMap(data)
+	if len(validationErr) != 2 || validationErr.Get("removed") == "" || validationErr.Get("hidden") == "" {
+		t.Log(validationErr)
+		t.Error("null rule failed to validate the empty values!")
+	}
+}
+
+func Test_Required_NestedStruct(t *testing.T) {
+	type Address struct {
+		City string `json:"city"`
+	}
+	type User struct {
+		Name    string            `json:"name"`
+		Address Address           `json:"address"`
+		Meta    map[string]string `json:"meta"`
+	}
+	rules := MapData{
+		"address": []string{"required", "object"},
+		"meta":    []string{"required"},
+		"city":    []string{"required"},
+	}
+
+	user := User{Address: Address{City: "Dhaka"}, Meta: map[string]string{"k": "v"}}
+	validationErr := New(Options{Data: &user, Rules: rules}).ValidateStruct()
+	if len(validationErr) != 0 {
+		t.Log(validationErr)
+		t.Error("required rule failed for nested struct in ValidateStruct!")
+	}
+
+	req, _ := http.NewRequest("POST", "http://www.example.com", bytes.NewReader([]byte(`{"name":"John"}`)))
+	user = User{}
+	validationErr = New(Options{Request: req, Data: &user, Rules: rules}).ValidateJSON()
+	if len(validationErr) != 3 || validationErr.Get("address") == "" || validationErr.Get("meta") == "" {
+		t.Log(validationErr)
+		t.Error("required rule failed for missing nested struct in ValidateJSON!")
+	}
+}
+
+func Test_Required_Boolean(t *testing.T) {
+	rules := MapData{"active": []string{"required", "boolean"}}
+
+	req, _ := http.NewRequest("POST", "http://www.example.com", bytes.NewReader([]byte(`{"active":false}`)))
+	data := map[string]interface{}{}
+	if validationErr := New(Options{Request: req, Data: &data, Rules: rules}).ValidateJSON(); len(validationErr) != 0 {
+		t.Errorf("required rule failed for JSON boolean: %v", validationErr)
+	}
+
+	req, _ = http.NewRequest("POST", "http://www.example.com", bytes.NewReader([]byte(`{}`)))
+	data = map[string]interface{}{}
+	if validationErr := New(Options{Request: req, Data: &data, Rules: rules}).ValidateJSON(); validationErr.Get("active") == "" {
+		t.Error("required rule failed for missing JSON boolean")
+	}
+
+	type User struct {
+		Active bool `json:"active"`
+	}
+	if validationErr := New(Options{Data: &User{}, Rules: rules}).ValidateStruct(); len(validationErr) != 0 {
+		t.Errorf("required rule failed for bool struct field: %v", validationErr)
+	}
+}
@@ -0,0 +1,259 @@
+package govalidator
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// LoadRules read the rules from JSON or line based text format and validate them against the registered rules
+//
+// JSON format maps the field to a list of rules or a pipe separated string
+//
+//	{"username": ["required", "between:3,8"], "email": "required|email"}
+//
+// Text format contains a field per line separated from the pipe separated rules by ": ", blank lines and lines starting with # are ignored
+// the arguments of the rules like min, between or before are checked too
+//
+//	username: required|between:3,8
+//	email: required|email
+//
+// the pipe always separates the rules of the text format and the JSON string, a regex containing a pipe
+// e.g: regex:^(jpg|png)$ must be written in the JSON list
+func LoadRules(r io.Reader) (MapData, error) {
+	rules, err := loadMapData(r, "|")
+	if err != nil {
+		return nil, err
+	}
+	for field, list := range rules {
+		for i, rule := range list {
+			if isRuleExist(rule) {
+				continue
+			}
+			if i > 0 && strings.HasPrefix(list[i-1], "regex:") {
+				return nil, fmt.Errorf("govalidator: %s is not a valid rule of the field %s, a regex containing | must be written in the JSON list", rule, field)
+			}
+			return nil, fmt.Errorf("govalidator: %s is not a valid rule of the field %s", rule, field)
+		}
+		for _, rule := range list {
+			if !isValidRuleArgs(strings.TrimPrefix(rule, eachRulePrefix)) {
+				return nil, fmt.Errorf("govalidator: %s has invalid arguments for the field %s", rule, field)
+			}
+		}
+	}
+	return rules, nil
+}
+
+// isValidRuleArgs check the arguments of the built-in rules which panic on invalid arguments while validating
+// e.g: between requires two numbers or dates, the other rules are not checked
+func isValidRuleArgs(rule string) bool {
+	parts := strings.SplitN(rule, ":", 2)
+	args := []string{}
+	if len(parts) == 2 {
+		args = strings.Split(parts[1], ",")
+	}
+	isInt := func(s string) bool {
+		_, err := strconv.Atoi(s)
+		return err == nil
+	}
+	isFloat := func(s string) bool {
+		_, err := strconv.ParseFloat(s, 64)
+		return err == nil
+	}
+	switch parts[0] {
+	case "min", "max", "len", "digits":
+		return len(args) == 1 && isInt(args[0])
+	case "digits_between":
+		return len(args) == 2 && isInt(args[0]) && isInt(args[1])
+	case "between":
+		return len(args) == 2 && ((isFloat(args[0]) && isFloat(args[1])) || (isTimeArg(args[0]) && isTimeArg(args[1])))
+	case "numeric_between":
+		return len(args) == 2 && (args[0] != "" || args[1] != "") &&
+			(args[0] == "" || isFloat(args[0])) && (args[1] == "" || isFloat(args[1]))
+	case "before", "after":
+		return len(parts) == 2 && isTimeArg(parts[1])
+	}
+	return true
+}
+
+// LoadMessages read the messages from JSON or line based text format and validate the rule of every message
+//
+// JSON format maps the field to a list of rule:message or an object of the rule and the message
+//
+//	{"username": ["required:You must provide username"], "email": {"email": "The {attribute} is invalid"}}
+//
+// Text format contains a message per line separated from the field by ": ", a field can be repeated for several rules
+//
+//	username: required:You must provide username
+//	username: between:The {attribute} must be between {min} and {max} chars
+func LoadMessages(r io.Reader) (MapData, error) {
+	messages, err := loadMapData(r, "")
+	if err != nil {
+		return nil, err
+	}
+	for field, list := range messages {
+		for _, m := range list {
+			rule := strings.SplitN(m, ":", 2)[0]
+			if !strings.Contains(m, ":") || (!isRuleExist(rule) && !isIn(messageRules, rule)) {
+				return nil, fmt.Errorf("govalidator: %s is not a valid message of the field %s", m, field)
+			}
+		}
+	}
+	return messages, nil
+}
+
+// loadMapData read the JSON or text format into MapData, the format is detected by the first character
+// sep split the value of the text format and the JSON string, empty sep keeps the value as is
+func loadMapData(r io.Reader, sep string) (MapData, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '{' {
+		return loadJSONMapData(b, sep)
+	}
+	return loadTextMapData(b, sep)
+}
+
+// loadJSONMapData decode the JSON object of the fields, the value of a field can be a list of strings, a string or an object
+func loadJSONMapData(b []byte, sep string) (MapData, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf("govalidator: invalid JSON config: %v", err)
+	}
+	data := MapData{}
+	for field, val := range raw {
+		var list []string
+		var str string
+		var obj map[string]string
+		switch {
+		case json.Unmarshal(val, &list) == nil:
+			data[field] = list
+		case json.Unmarshal(val, &str) == nil:
+			data[field] = splitConfigValue(str, sep)
+		case json.Unmarshal(val, &obj) == nil:
+			keys := make([]string, 0, len(obj))
+			for k := range obj {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				data[field] = append(data[field], k+":"+obj[k])
+			}
+		default:
+			return nil, fmt.Errorf("govalidator: invalid JSON config of the field %s", field)
+		}
+	}
+	return data, nil
+}
+
+// loadTextMapData read the field: value lines
+func loadTextMapData(b []byte, sep string) (MapData, error) {
+	data := MapData{}
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		i := strings.Index(text, ": ")
+		if i <= 0 {
+			return nil, fmt.Errorf("govalidator: invalid config at line %d: %s", line, text)
+		}
+		field := strings.TrimSpace(text[:i])
+		data[field] = append(data[field], splitConfigValue(strings.TrimSpace(text[i+2:]), sep)...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// splitConfigValue split the value by the separator ignoring the empty parts
+func splitConfigValue(val, sep string) []string {
+	if sep == "" {
+		return []string{val}
+	}
+	var list []string
+	for _, s := range strings.Split(val, sep) {
+		if s = strings.TrimSpace(s); s != "" {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+// Schema represents the rules and messages of a validation which can be reloaded at runtime
+// the validators created by the schema use the rules and messages loaded at the time of creation
+type Schema struct {
+	value atomic.Value
+}
+
+// schemaData represents a snapshot of the schema
+type schemaData struct {
+	rules    MapData
+	messages MapData
+}
+
+// NewSchema return a new schema using the rules and messages
+func NewSchema(rules, messages MapData) *Schema {
+	s := &Schema{}
+	s.Store(rules, messages)
+	return s
+}
+
+// Store replace the rules and messages of the schema atomically
+func (s *Schema) Store(rules, messages MapData) {
+	s.value.Store(schemaData{rules: rules, messages: messages})
+}
+
+// Load read the rules and the messages using LoadRules and LoadMessages and replace them atomically
+// messages can be nil, the schema is not changed if any of them is invalid
+func (s *Schema) Load(rules, messages io.Reader) error {
+	r, err := LoadRules(rules)
+	if err != nil {
+		return err
+	}
+	m := MapData{}
+	if messages != nil {
+		if m, err = LoadMessages(messages); err != nil {
+			return err
+		}
+	}
+	s.Store(r, m)
+	return nil
+}
+
+// Rules return the current rules of the schema
+func (s *Schema) Rules() MapData {
+	return s.load().rules
+}
+
+// Messages return the current messages of the schema
+func (s *Schema) Messages() MapData {
+	return s.load().messages
+}
+
+// New return a new validator using the options and the current rules and messages of the schema
+func (s *Schema) New(opts Options) *Validator {
+	d := s.load()
+	opts.Rules = d.rules
+	opts.Messages = d.messages
+	return New(opts)
+}
+
+// load return the current snapshot of the schema
+func (s *Schema) load() schemaData {
+	d, _ := s.value.Load().(schemaData)
+	return d
+}
@@ -0,0 +1,127 @@
+package govalidator
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadRules(t *testing.T) {
+	expected := MapData{
+		"username":   []string{"required", "between:3,8"},
+		"email":      []string{"required", "email"},
+		"tags":       []string{"each:alpha"},
+		"file:photo": []string{"ext:jpg,png"},
+	}
+	list := map[string]string{
+		"json": `{"username": ["required", "between:3,8"], "email": "required|email", "tags": ["each:alpha"], "file:photo": "ext:jpg,png"}`,
+		"text": `
+# user rules
+username: required|between:3,8
+email: required
+email: email
+
+tags: each:alpha
+file:photo: ext:jpg,png
+`,
+	}
+	for name, config := range list {
+		rules, err := LoadRules(strings.NewReader(config))
+		if err != nil {
+			t.Fatalf("LoadRules failed for %s: %v", name, err)
+		}
+		if !reflect.DeepEqual(rules, expected) {
+			t.Errorf("LoadRules failed for %s: %v", name, rules)
+		}
+	}
+}
+
+func TestLoadRules_invalid(t *testing.T) {
+	list := []string{
+		`{"username": ["required", "unknown_rule"]}`,
+		`{"username": 10}`,
+		`{"username": [}`,
+		`username: each:unknown_rule`,
+		`username required`,
+		`{"a": ["between:3"]}`,
+		`{"a": ["min:x"]}`,
+		`{"a": ["len:1.5"]}`,
+		`{"a": ["digits_between:1,b"]}`,
+		`{"a": ["numeric_between:,"]}`,
+		`{"a": ["before:someday"]}`,
+		`tags: each:max`,
+	}
+	for _, config := range list {
+		if _, err := LoadRules(strings.NewReader(config)); err == nil {
+			t.Errorf("LoadRules failed to report the invalid config: %s", config)
+		}
+	}
+}
+
+func TestLoadRules_validArgs(t *testing.T) {
+	config := `{"a": ["between:3,8", "numeric_between:,10.5", "digits_between:1,3", "before:tomorrow", "after:2020-01-01", "each:min:1"],
+		"b": ["between:2020-01-01,2020-12-31", "required"]}`
+	if _, err := LoadRules(strings.NewReader(config)); err != nil {
+		t.Errorf("LoadRules failed for valid arguments: %v", err)
+	}
+}
+
+func TestLoadRules_regexPipe(t *testing.T) {
+	rules, err := LoadRules(strings.NewReader(`{"ext": ["required", "regex:^(jpg|png)$"]}`))
+	if err != nil || !reflect.DeepEqual(rules["ext"], []string{"required", "regex:^(jpg|png)$"}) {
+		t.Errorf("LoadRules failed to keep the pipe of regex in JSON list: %v %v", rules, err)
+	}
+	_, err = LoadRules(strings.NewReader(`ext: required|regex:^(jpg|png)$`))
+	if err == nil || !strings.Contains(err.Error(), "JSON list") {
+		t.Errorf("LoadRules failed to report the regex split by pipe: %v", err)
+	}
+}
+
+func TestLoadMessages(t *testing.T) {
+	expected := MapData{
+		"username": []string{"between:The {attribute} must be 3-8 chars", "required:You must provide username"},
+		"_error":   []string{"syntax:invalid body: check it"},
+	}
+	list := map[string]string{
+		"json": `{"username": {"required": "You must provide username", "between": "The {attribute} must be 3-8 chars"}, "_error": ["syntax:invalid body: check it"]}`,
+		"text": "username: between:The {attribute} must be 3-8 chars\nusername: required:You must provide username\n_error: syntax:invalid body: check it",
+	}
+	for name, config := range list {
+		messages, err := LoadMessages(strings.NewReader(config))
+		if err != nil {
+			t.Fatalf("LoadMessages failed for %s: %v", name, err)
+		}
+		if !reflect.DeepEqual(messages, expected) {
+			t.Errorf("LoadMessages failed for %s: %v", name, messages)
+		}
+	}
+	if _, err := LoadMessages(strings.NewReader(`username: unknown_rule:message`)); err == nil {
+		t.Error("LoadMessages failed to report the unknown rule")
+	}
+}
+
+func TestSchema_Load(t *testing.T) {
+	schema := NewSchema(MapData{"name": []string{"required"}}, nil)
+	validate := func() url.Values {
+		return schema.New(Options{}).ValidateValues(url.Values{"name": []string{"jo"}})
+	}
+	if len(validate()) != 0 {
+		t.Error("Schema failed to validate using the initial rules")
+	}
+
+	err := schema.Load(strings.NewReader("name: min:3"), strings.NewReader("name: min:too short"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if validate().Get("name") != "too short" {
+		t.Error("Schema failed to reload the rules and messages")
+	}
+
+	if err := schema.Load(strings.NewReader("name: max:1"), strings.NewReader("name: invalid")); err == nil {
+		t.Error("Schema failed to report the invalid messages")
+	}
+	if !reflect.DeepEqual(schema.Rules(), MapData{"name": []string{"min:3"}}) {
+		t.Errorf("Schema changed the rules on invalid config: %v", schema.Rules())
+	}
+}
@@ -0,0 +1,69 @@
+package govalidator
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// timeType represents the type of time.Time, it is validated as a single value instead of a struct
+var timeType = reflect.TypeOf(time.Time{})
+
+// timeLayouts represents the accepted layouts to parse time.Time from string value or rule argument
+var timeLayouts = []string{time.RFC3339Nano, time.RFC3339, "2006-01-02T15:04", "2006-01-02 15:04:05", "2006-01-02", "2006/01/02"}
+
+// isTimeValue check if the value is time.Time or a pointer to it
+func isTimeValue(value interface{}) bool {
+	t := reflect.TypeOf(value)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t == timeType
+}
+
+// parseTime return the time of time.Time value or the string value in any of the timeLayouts
+func parseTime(value interface{}) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// isTimeArg check if the date argument of a rule can be parsed by parseTimeArg
+func isTimeArg(arg string) bool {
+	switch strings.TrimSpace(arg) {
+	case "now", "today", "tomorrow", "yesterday":
+		return true
+	}
+	_, ok := parseTime(arg)
+	return ok
+}
+
+// parseTimeArg parse the date argument of the rules like before and after
+// now, today, tomorrow and yesterday are relative to the current time
+func parseTimeArg(arg string) time.Time {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch strings.TrimSpace(arg) {
+	case "now":
+		return now
+	case "today":
+		return today
+	case "tomorrow":
+		return today.AddDate(0, 0, 1)
+	case "yesterday":
+		return today.AddDate(0, 0, -1)
+	}
+	t, ok := parseTime(arg)
+	if !ok {
+		panic(errStringToTime)
+	}
+	return t
+}
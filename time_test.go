@@ -0,0 +1,85 @@
+package govalidator
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRoller_TimeValue(t *testing.T) {
+	now := time.Now()
+	type event struct {
+		Start time.Time  `json:"start"`
+		End   *time.Time `json:"end"`
+	}
+	r := roller{}
+	r.setTagIdentifier("json")
+	r.setTagSeparator("|")
+	r.start(&event{Start: now, End: &now})
+	if len(r.getFlatMap()) != 2 {
+		t.Log(r.getFlatMap())
+		t.Error("roller failed to push time.Time as a single value")
+	}
+	if start, _ := r.getFlatVal("start"); start != now {
+		t.Error("roller failed to push time.Time value")
+	}
+}
+
+func TestValidator_ValidateStruct_Time(t *testing.T) {
+	start := time.Date(2020, 5, 10, 0, 0, 0, 0, time.UTC)
+	type event struct {
+		Start    time.Time  `json:"start"`
+		End      *time.Time `json:"end"`
+		Deadline time.Time  `json:"deadline"`
+		Created  string     `json:"created"`
+		Expiry   time.Time  `json:"expiry"`
+	}
+	data := event{
+		Start:   start,
+		End:     &start,
+		Created: "2021-01-02",
+		Expiry:  start,
+	}
+	opts := Options{
+		Data: &data,
+		Rules: MapData{
+			"start":    []string{"required", "date", "after:2020-01-01", "before:today"},
+			"end":      []string{"required", "after:2020-06-01"},
+			"deadline": []string{"required"},
+			"created":  []string{"before:2020-12-31T00:00:00Z"},
+			"expiry":   []string{"between:2020-06-01,2020-12-31"},
+		},
+	}
+
+	validationErr := New(opts).ValidateStruct()
+	expected := map[string]string{
+		"end":      "The end field must be a date after 2020-06-01",
+		"deadline": "The deadline field is required",
+		"created":  "The created field must be a date before 2020-12-31T00:00:00Z",
+		"expiry":   "The expiry field must be between 2020-06-01 and 2020-12-31",
+	}
+	if len(validationErr) != len(expected) {
+		t.Log(validationErr)
+		t.Error("ValidateStruct failed to validate time")
+	}
+	for field, msg := range expected {
+		if validationErr.Get(field) != msg {
+			t.Errorf("expected %q for %s, got %q", msg, field, validationErr.Get(field))
+		}
+	}
+}
+
+func Test_Before_InvalidArgument_panic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("before with invalid date did not panic")
+		}
+	}()
+
+	data := map[string]interface{}{"start": time.Now()}
+	opts := Options{
+		Rules: MapData{
+			"start": []string{"before:someday"},
+		},
+	}
+	New(opts).ValidateMap(data)
+}
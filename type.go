@@ -2,132 +2,147 @@ package govalidator
 
 import (
 	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
 	"encoding/json"
+	"fmt"
+	"time"
 )
 
-// Int describes a custom type of built-in int data type
-type Int struct {
-	Value int  `json:"value"`
-	IsSet bool `json:"isSet"`
+// Nullable describes a value which can be absent, null or set
+// e.g: {} leaves both IsSet and Null false, {"age": null} set Null and {"age": 18} set IsSet
+// the value is kept in V like sql.Null as Value is the method of driver.Valuer
+type Nullable[T any] struct {
+	V     T    // V represents the value if IsSet
+	IsSet bool // IsSet represents if a non null value is provided
+	Null  bool // Null represents if null is provided explicitly
 }
 
-var null = []byte("null")
+type (
+	// Int describes a custom type of built-in int data type
+	Int = Nullable[int]
+	// Int64 describes a custom type of built-in int64 data type
+	Int64 = Nullable[int64]
+	// Uint describes a custom type of built-in uint data type
+	Uint = Nullable[uint]
+	// Float32 describes a custom type of built-in float32 data type
+	Float32 = Nullable[float32]
+	// Float64 describes a custom type of built-in float64 data type
+	Float64 = Nullable[float64]
+	// Bool describes a custom type of built-in bool data type
+	Bool = Nullable[bool]
+	// String describes a custom type of built-in string data type
+	String = Nullable[string]
+	// Time describes a custom type of time.Time
+	Time = Nullable[time.Time]
+)
 
-// UnmarshalJSON ...
-func (i *Int) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, null) {
-		return nil
-	}
-	i.IsSet = true
-	var temp int
-	if err := json.Unmarshal(data, &temp); err != nil {
-		return err
-	}
-	i.Value = temp
-	return nil
+// nullable is implemented by Nullable, roller push it as a single value instead of traversing its fields
+type nullable interface {
+	// nullableValue return the underlying value and if a non null value is set
+	nullableValue() (interface{}, bool)
 }
 
-// MarshalJSON ...
-func (i *Int) MarshalJSON() ([]byte, error) {
-	return json.Marshal(i.Value)
-}
+var null = []byte("null")
 
-// Int64 describes a custom type of built-in int64 data type
-type Int64 struct {
-	Value int64 `json:"value"`
-	IsSet bool  `json:"isSet"`
+// NewNullable return a Nullable holding the value
+func NewNullable[T any](value T) Nullable[T] {
+	return Nullable[T]{V: value, IsSet: true}
 }
 
-// UnmarshalJSON ...
-func (i *Int64) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, null) {
-		return nil
-	}
-	i.IsSet = true
-	var temp int64
-	if err := json.Unmarshal(data, &temp); err != nil {
-		return err
-	}
-	i.Value = temp
-	return nil
+// nullableValue return the underlying value and if a non null value is set
+func (n Nullable[T]) nullableValue() (interface{}, bool) {
+	return n.V, n.IsSet
 }
 
-// MarshalJSON ...
-func (i *Int64) MarshalJSON() ([]byte, error) {
-	return json.Marshal(i.Value)
+// Get return the value and if a non null value is set
+func (n Nullable[T]) Get() (T, bool) {
+	return n.V, n.IsSet
 }
 
-// Float32 describes a custom type of built-in float32 data type
-type Float32 struct {
-	Value float32 `json:"value"`
-	IsSet bool    `json:"isSet"`
+// IsAbsent check if neither a value nor null is provided
+func (n Nullable[T]) IsAbsent() bool {
+	return !n.IsSet && !n.Null
 }
 
 // UnmarshalJSON ...
-func (i *Float32) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, null) {
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), null) {
+		*n = Nullable[T]{Null: true}
 		return nil
 	}
-	i.IsSet = true
-	var temp float32
+	var temp T
 	if err := json.Unmarshal(data, &temp); err != nil {
 		return err
 	}
-	i.Value = temp
+	*n = Nullable[T]{V: temp, IsSet: true}
 	return nil
 }
 
-// MarshalJSON ...
-func (i *Float32) MarshalJSON() ([]byte, error) {
-	return json.Marshal(i.Value)
-}
-
-// Float64 describes a custom type of built-in float64 data type
-type Float64 struct {
-	Value float64 `json:"value"`
-	IsSet bool    `json:"isSet"`
+// MarshalJSON encode the value, absent and null are encoded as null
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.IsSet {
+		return null, nil
+	}
+	return json.Marshal(n.V)
 }
 
-// UnmarshalJSON ...
-func (i *Float64) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, null) {
+// UnmarshalText decode the text e.g: a form value, empty text is considered as null
+func (n *Nullable[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*n = Nullable[T]{Null: true}
 		return nil
 	}
-	i.IsSet = true
-	var temp float64
-	if err := json.Unmarshal(data, &temp); err != nil {
+	var temp T
+	var err error
+	switch t := interface{}(&temp).(type) {
+	case encoding.TextUnmarshaler:
+		err = t.UnmarshalText(text)
+	case *string:
+		*t = string(text)
+	default:
+		err = json.Unmarshal(text, &temp)
+	}
+	if err != nil {
 		return err
 	}
-	i.Value = temp
+	*n = Nullable[T]{V: temp, IsSet: true}
 	return nil
 }
 
-// MarshalJSON ...
-func (i *Float64) MarshalJSON() ([]byte, error) {
-	return json.Marshal(i.Value)
-}
-
-// Bool describes a custom type of built-in bool data type
-type Bool struct {
-	Value bool `json:"value"`
-	IsSet bool `json:"isSet"`
+// MarshalText encode the value as text, null is encoded as empty text
+func (n Nullable[T]) MarshalText() ([]byte, error) {
+	if !n.IsSet {
+		return []byte{}, nil
+	}
+	switch t := interface{}(n.V).(type) {
+	case encoding.TextMarshaler:
+		return t.MarshalText()
+	case string:
+		return []byte(t), nil
+	}
+	return []byte(fmt.Sprint(n.V)), nil
 }
 
-// UnmarshalJSON ...
-func (i *Bool) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, null) {
+// Scan implements the sql.Scanner interface
+func (n *Nullable[T]) Scan(src interface{}) error {
+	if src == nil {
+		*n = Nullable[T]{Null: true}
 		return nil
 	}
-	i.IsSet = true
-	var temp bool
-	if err := json.Unmarshal(data, &temp); err != nil {
+	var temp sql.Null[T]
+	if err := temp.Scan(src); err != nil {
 		return err
 	}
-	i.Value = temp
+	*n = Nullable[T]{V: temp.V, IsSet: true}
 	return nil
 }
 
-// MarshalJSON ...
-func (i *Bool) MarshalJSON() ([]byte, error) {
-	return json.Marshal(i.Value)
+// Value implements the driver.Valuer interface
+func (n Nullable[T]) Value() (driver.Value, error) {
+	if !n.IsSet {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.V)
 }
@@ -0,0 +1,103 @@
+package govalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNullable_UnmarshalJSON(t *testing.T) {
+	var data struct {
+		Age   Int    `json:"age"`
+		Name  String `json:"name"`
+		Score Float64
+	}
+	if err := json.Unmarshal([]byte(`{"age":null,"name":"John"}`), &data); err != nil {
+		t.Fatal(err)
+	}
+	if !data.Age.Null || data.Age.IsSet || data.Age.IsAbsent() {
+		t.Errorf("Nullable failed to track null: %+v", data.Age)
+	}
+	if v, ok := data.Name.Get(); !ok || v != "John" {
+		t.Errorf("Nullable failed to decode value: %+v", data.Name)
+	}
+	if !data.Score.IsAbsent() {
+		t.Errorf("Nullable failed to track absent value: %+v", data.Score)
+	}
+
+	b, _ := json.Marshal(data)
+	if string(b) != `{"age":null,"name":"John","Score":null}` {
+		t.Errorf("Nullable failed to encode: %s", b)
+	}
+}
+
+func TestNullable_Text(t *testing.T) {
+	var tm Time
+	if err := tm.UnmarshalText([]byte("2020-01-02T15:04:05Z")); err != nil || !tm.IsSet {
+		t.Errorf("Nullable failed to decode text time: %v", err)
+	}
+	var b Bool
+	if err := b.UnmarshalText([]byte("true")); err != nil || !b.V {
+		t.Errorf("Nullable failed to decode text bool: %v", err)
+	}
+	var u Uint
+	if err := u.UnmarshalText([]byte("-1")); err == nil {
+		t.Error("Nullable failed to reject invalid uint")
+	}
+	if err := u.UnmarshalText(nil); err != nil || !u.Null {
+		t.Error("Nullable failed to decode empty text as null")
+	}
+	text, _ := NewNullable(42).MarshalText()
+	if string(text) != "42" {
+		t.Errorf("Nullable failed to encode text: %s", text)
+	}
+}
+
+func TestNullable_SQL(t *testing.T) {
+	var n Int64
+	if err := n.Scan(int64(7)); err != nil || n.V != 7 || !n.IsSet {
+		t.Errorf("Nullable failed to scan: %v %+v", err, n)
+	}
+	if err := n.Scan(nil); err != nil || !n.Null {
+		t.Errorf("Nullable failed to scan null: %v %+v", err, n)
+	}
+	if v, _ := n.Value(); v != nil {
+		t.Errorf("Nullable failed to return nil driver value: %v", v)
+	}
+	if v, _ := NewNullable(7).Value(); v != int64(7) {
+		t.Errorf("Nullable failed to return driver value: %v", v)
+	}
+	if v, _ := NewNullable(time.Unix(0, 0)).Value(); v == nil {
+		t.Error("Nullable failed to return time driver value")
+	}
+}
+
+func TestValidator_ValidateJSON_Nullable(t *testing.T) {
+	type user struct {
+		Age     Int     `json:"age"`
+		Name    String  `json:"name"`
+		Balance *Uint64 `json:"balance"`
+	}
+	req, _ := http.NewRequest("POST", "http://www.example.com", bytes.NewReader([]byte(`{"age":null,"balance":5}`)))
+
+	data := user{}
+	opts := Options{
+		Request: req,
+		Data:    &data,
+		Rules: MapData{
+			"age":     []string{"required"},
+			"name":    []string{"required"},
+			"balance": []string{"required"},
+		},
+	}
+	validationErr := New(opts).ValidateJSON()
+	if len(validationErr) != 2 || validationErr.Get("balance") != "" {
+		t.Log(validationErr)
+		t.Error("ValidateJSON failed to validate Nullable")
+	}
+}
+
+// Uint64 is a Nullable defined in the test to check the roller recognize any instantiation
+type Uint64 = Nullable[uint64]
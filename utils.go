@@ -1,7 +1,11 @@
 package govalidator
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"math/big"
+	"net/url"
 	"reflect"
 	"strings"
 )
@@ -17,7 +21,11 @@ func isContainRequiredField(rules []string) bool {
 }
 
 // isRuleExist check if the provided rule name is exist or not
+// each: is accepted only with an element rule e.g: each:email
 func isRuleExist(rule string) bool {
+	if strings.HasPrefix(rule, eachRulePrefix) {
+		return isRuleExist(strings.TrimPrefix(rule, eachRulePrefix))
+	}
 	if strings.Contains(rule, ":") {
 		rule = strings.Split(rule, ":")[0]
 	}
@@ -55,3 +63,60 @@ func isEmpty(x interface{}) bool {
 	}
 	return reflect.DeepEqual(x, reflect.Zero(rt).Interface())
 }
+
+// mergeErrors add all the errors of src into the error bag
+func mergeErrors(errsBag url.Values, src url.Values) {
+	for field, errs := range src {
+		for _, e := range errs {
+			errsBag.Add(field, e)
+		}
+	}
+}
+
+// jsonNumberValue convert the json.Number to int64 or float64
+func jsonNumberValue(n json.Number) interface{} {
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+	if f, err := n.Float64(); err == nil {
+		return f
+	}
+	return n.String()
+}
+
+// isIntegerValue check if the value is an integer kind, an integral float or an integer json.Number
+func isIntegerValue(value interface{}) bool {
+	if n, ok := value.(json.Number); ok {
+		_, err := n.Int64()
+		if err != nil {
+			// big integers keep the precision as json.Number
+			_, ok = new(big.Int).SetString(n.String(), 10)
+			return ok
+		}
+		return true
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		return !math.IsInf(f, 0) && f == math.Trunc(f)
+	}
+	return false
+}
+
+// isNumberValue check if the value is an integer or float kind or a json.Number
+func isNumberValue(value interface{}) bool {
+	if _, ok := value.(json.Number); ok {
+		return true
+	}
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
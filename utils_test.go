@@ -29,6 +29,12 @@ func Test_isRuleExist(t *testing.T) {
 	if isRuleExist("not exist") {
 		t.Error("isRuleExist failed for invalid rule")
 	}
+	if isRuleExist("each") || isRuleExist("each:") || isRuleExist("each:not_exist") {
+		t.Error("isRuleExist failed for each without a valid element rule")
+	}
+	if !isRuleExist("each:between:2,10") {
+		t.Error("isRuleExist failed for each with element rule")
+	}
 	if !isRuleExist("mime") {
 		t.Error("extended rules failed")
 	}
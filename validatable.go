@@ -0,0 +1,61 @@
+package govalidator
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+)
+
+type (
+	// Validatable describes a data structure which can validate invariants that span the whole object,
+	// e.g: "at least one contact method must be provided"
+	// The returned errors are merged into the error bag after the field rules run
+	Validatable interface {
+		Validate(ctx context.Context) url.Values
+	}
+
+	// RulesProvider describes a data structure which provides its own rules
+	// The provided rules are validated after the rules passed in Options
+	RulesProvider interface {
+		Rules() MapData
+	}
+)
+
+// asSelfValidator return the value as Validatable or RulesProvider if it implements any of them
+// value receivers and pointer receivers are both detected, non addressable values are copied
+func asSelfValidator(iface interface{}) (interface{}, bool) {
+	if iface == nil {
+		return nil, false
+	}
+	switch iface.(type) {
+	case Validatable, RulesProvider:
+		return iface, true
+	}
+	rv := reflect.ValueOf(iface)
+	if rv.Kind() == reflect.Ptr {
+		return nil, false
+	}
+	ptr := reflect.New(rv.Type())
+	ptr.Elem().Set(rv)
+	switch ptr.Interface().(type) {
+	case Validatable, RulesProvider:
+		return ptr.Interface(), true
+	}
+	return nil, false
+}
+
+// validateSelf run the struct level validation of the collected data structures and merge the errors
+func (v *Validator) validateSelf(r *roller, errsBag url.Values, skip map[string]struct{}, loc *localizer) {
+	ctx := context.Background()
+	if v.Opts.Request != nil {
+		ctx = v.Opts.Request.Context()
+	}
+	for _, sv := range r.getSelfValidators() {
+		if rp, ok := sv.(RulesProvider); ok {
+			v.validateFlatRules(rp.Rules(), r, errsBag, skip, loc)
+		}
+		if vd, ok := sv.(Validatable); ok {
+			mergeErrors(errsBag, vd.Validate(ctx))
+		}
+	}
+}
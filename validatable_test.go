@@ -0,0 +1,93 @@
+package govalidator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+type contact struct {
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+}
+
+func (c contact) Validate(ctx context.Context) url.Values {
+	errsBag := url.Values{}
+	if c.Email == "" && c.Phone == "" {
+		errsBag.Add("contact", "At least one contact method is required")
+	}
+	return errsBag
+}
+
+type customer struct {
+	Name    string  `json:"name"`
+	Zip     string  `json:"zip"`
+	Contact contact `json:"contact"`
+}
+
+func (c *customer) Rules() MapData {
+	return MapData{
+		"zip": []string{"digits:4"},
+	}
+}
+
+func TestValidator_ValidateStruct_Validatable(t *testing.T) {
+	c := customer{Name: "John", Zip: "12"}
+	opts := Options{
+		Data: &c,
+		Rules: MapData{
+			"name": []string{"required"},
+		},
+	}
+
+	validationErr := New(opts).ValidateStruct()
+	if len(validationErr) != 2 {
+		t.Log(validationErr)
+		t.Error("ValidateStruct failed to run struct level validation")
+	}
+	if validationErr.Get("contact") == "" {
+		t.Error("ValidateStruct failed to merge Validatable errors")
+	}
+	if validationErr.Get("zip") == "" {
+		t.Error("ValidateStruct failed to validate RulesProvider rules")
+	}
+}
+
+func TestValidator_ValidateJSON_RulesProviderOnly(t *testing.T) {
+	var c customer
+	body, _ := json.Marshal(map[string]interface{}{
+		"zip":     "1234",
+		"contact": map[string]string{"phone": "01700000000"},
+	})
+	req, _ := http.NewRequest("POST", "http://www.example.com", bytes.NewReader(body))
+
+	validationErr := New(Options{Request: req, Data: &c}).ValidateJSON()
+	if len(validationErr) != 0 {
+		t.Log(validationErr)
+		t.Error("ValidateJSON failed to validate with RulesProvider rules only")
+	}
+}
+
+func TestValidator_ValidateStruct_ValidatableOnly(t *testing.T) {
+	c := contact{}
+	validationErr := New(Options{Data: &c}).ValidateStruct()
+	if validationErr.Get("contact") == "" {
+		t.Log(validationErr)
+		t.Error("ValidateStruct failed to validate with Validatable only")
+	}
+}
+
+func Test_asSelfValidator(t *testing.T) {
+	if _, ok := asSelfValidator(contact{}); !ok {
+		t.Error("asSelfValidator failed to detect value receiver")
+	}
+	if _, ok := asSelfValidator(customer{}); !ok {
+		t.Error("asSelfValidator failed to detect pointer receiver")
+	}
+	if _, ok := asSelfValidator(male); ok {
+		t.Error("asSelfValidator detected a plain struct")
+	}
+}
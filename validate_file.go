@@ -9,7 +9,8 @@ import (
 )
 
 // validateFiles validate file size, mimes, extension etc
-func validateFiles(r *http.Request, field, rule, msg string, errsBag url.Values) {
+// attribute is the display name of the field used in the messages, templates provide the localized default messages
+func validateFiles(r *http.Request, field, attribute, rule, msg string, errsBag url.Values, templates templateFunc) {
 	_, _, ext, mime, size, fErr := getFileInfo(r, field)
 	// check size
 	if strings.HasPrefix(rule, "size:") {
@@ -18,14 +19,10 @@ func validateFiles(r *http.Request, field, rule, msg string, errsBag url.Values)
 			panic(errStringToInt)
 		}
 		if size > l {
-			if msg != "" {
-				errsBag.Add(field, msg)
-			} else {
-				errsBag.Add(field, fmt.Sprintf("The %s field size is can not be greater than %d bytes", field, l))
-			}
+			errsBag.Add(field, renderError(ruleError("size", msg, attribute, nil, "size", strconv.FormatInt(l, 10)), templates))
 		}
 		if fErr != nil {
-			errsBag.Add(field, fmt.Sprintf("The %s field failed to read file when fetching size", field))
+			errsBag.Add(field, fmt.Sprintf("The %s field failed to read file when fetching size", attribute))
 		}
 	}
 
@@ -39,14 +36,10 @@ func validateFiles(r *http.Request, field, rule, msg string, errsBag url.Values)
 			}
 		}
 		if !f {
-			if msg != "" {
-				errsBag.Add(field, msg)
-			} else {
-				errsBag.Add(field, fmt.Sprintf("The %s field file extension %s is invalid", field, ext))
-			}
+			errsBag.Add(field, renderError(ruleError("ext", msg, attribute, nil, "ext", ext), templates))
 		}
 		if fErr != nil {
-			errsBag.Add(field, fmt.Sprintf("The %s field failed to read file when fetching extension", field))
+			errsBag.Add(field, fmt.Sprintf("The %s field failed to read file when fetching extension", attribute))
 		}
 	}
 
@@ -60,14 +53,10 @@ func validateFiles(r *http.Request, field, rule, msg string, errsBag url.Values)
 			}
 		}
 		if !f {
-			if msg != "" {
-				errsBag.Add(field, msg)
-			} else {
-				errsBag.Add(field, fmt.Sprintf("The %s field file mime %s is invalid", field, mime))
-			}
+			errsBag.Add(field, renderError(ruleError("mime", msg, attribute, nil, "mime", mime), templates))
 		}
 		if fErr != nil {
-			errsBag.Add(field, fmt.Sprintf("The %s field failed to read file when fetching mime", field))
+			errsBag.Add(field, fmt.Sprintf("The %s field failed to read file when fetching mime", attribute))
 		}
 	}
 }
@@ -139,3 +139,22 @@ func Test_validateFiles_CustomRule(t *testing.T) {
 		t.Error("passed to custom rule value is not nil!")
 	}
 }
+
+func TestValidator_ValidateForm_Files(t *testing.T) {
+	type upload struct {
+		Title string `json:"title"`
+	}
+	validate := func(rules MapData) map[string][]string {
+		req, err := buildMocFormReq()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return New(Options{Request: req, Data: &upload{}, Rules: rules}).ValidateForm()
+	}
+	if errs := validate(MapData{"file:file": []string{"required", "ext:md"}, "title": []string{"max:10"}}); len(errs) != 0 {
+		t.Errorf("ValidateForm failed to validate the uploaded file: %v", errs)
+	}
+	if errs := validate(MapData{"file:file": []string{"ext:jpg"}, "title": []string{"required"}}); len(errs) != 2 || len(errs["file"]) != 1 {
+		t.Errorf("ValidateForm failed to report the file rule: %v", errs)
+	}
+}
@@ -1,8 +1,9 @@
 package govalidator
 
 import (
-	"encoding/json"
+	"bytes"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -10,9 +11,10 @@ import (
 )
 
 const (
-	tagIdentifier         = "json" //tagName idetify the struct tag for govalidator
-	tagSeparator          = "|"    //tagSeparator use to separate tags in struct
-	defaultFormSize int64 = 1024 * 1024 * 1
+	tagIdentifier          = "json" //tagName idetify the struct tag for govalidator
+	xmlTagIdentifier       = "xml"  //xmlTagIdentifier idetify the struct tag for XML body
+	tagSeparator           = "|"    //tagSeparator use to separate tags in struct
+	defaultFormSize  int64 = 1024 * 1024 * 1
 )
 
 type (
@@ -21,13 +23,21 @@ type (
 
 	// Options describes configuration option for validator
 	Options struct {
-		Data            interface{} // Data represents structure for JSON body
-		Request         *http.Request
-		RequiredDefault bool    // RequiredDefault represents if all the fields are by default required or not
-		Rules           MapData // Rules represents rules for form-data/x-url-encoded/query params data
-		Messages        MapData // Messages represents custom/localize message for rules
-		TagIdentifier   string  // TagIdentifier represents struct tag identifier, e.g: json or validate etc
-		FormSize        int64   //Form represents the multipart forom data max memory size in bytes
+		Data                 interface{} // Data represents structure for JSON body
+		Request              *http.Request
+		RequiredDefault      bool              // RequiredDefault represents if all the fields are by default required or not
+		Rules                MapData           // Rules represents rules for form-data/x-url-encoded/query params data
+		Messages             MapData           // Messages represents custom/localize message for rules
+		Attributes           map[string]string // Attributes represents the display names of the fields used in the messages
+		Locale               string            // Locale represents the language tag of the default messages, it overrides the locale of the request
+		DefaultMessages      map[string]string // DefaultMessages represents the message templates of the rules used for all the fields, e.g: email
+		TagIdentifier        string            // TagIdentifier represents struct tag identifier, e.g: json or validate etc
+		FormSize             int64             //Form represents the multipart forom data max memory size in bytes
+		MaxBodyBytes         int64             // MaxBodyBytes represents the max size of request body in bytes, zero means no limit
+		RestoreBody          bool              // RestoreBody represents if the request body is buffered and restored after validation
+		MaxDecompressedBytes int64             // MaxDecompressedBytes represents the max size of gzip or deflate encoded body after decompression, default 10MB
+		Strict               bool              // Strict represents if unknown fields, duplicate keys and trailing data in JSON body are reported
+		UseNumber            bool              // UseNumber represents if the JSON numbers are decoded as json.Number into interface{} to keep the precision of big integers
 	}
 
 	// Validator represents a validator with options
@@ -43,8 +53,13 @@ func New(opts Options) *Validator {
 
 // getMessage return if a custom message exist against the field name and rule
 // if not available it return an empty string
+// the message of wildcard key is used for the indexed field, e.g: items.*.sku for items.0.sku
 func (v *Validator) getCustomMessage(field, rule string) string {
-	if msgList, ok := v.Opts.Messages[field]; ok {
+	msgList, ok := v.Opts.Messages[field]
+	if !ok {
+		msgList, ok = v.Opts.Messages[wildcardKey(field)]
+	}
+	if ok {
 		for _, m := range msgList {
 			//if rules has params, remove params. e.g: between:3,5 would be between
 			if strings.Contains(rule, ":") {
@@ -65,6 +80,16 @@ func (v *Validator) SetDefaultRequired(required bool) {
 	v.Opts.RequiredDefault = required
 }
 
+// SetDefaultMessage change the default message template of the rule for all the fields of the validator
+// the key is the rule name e.g: email or a suffixed key e.g: min.string
+func (v *Validator) SetDefaultMessage(key, tmpl string) {
+	mustMessageKey(key)
+	if v.Opts.DefaultMessages == nil {
+		v.Opts.DefaultMessages = map[string]string{}
+	}
+	v.Opts.DefaultMessages[key] = tmpl
+}
+
 // SetTagIdentifier change the default tag identifier (json) to your custom tag.
 func (v *Validator) SetTagIdentifier(identifier string) {
 	v.Opts.TagIdentifier = identifier
@@ -78,12 +103,101 @@ func (v *Validator) Validate() url.Values {
 	if len(v.Opts.Rules) == 0 || v.Opts.Request == nil {
 		panic(errValidateArgsMismatch)
 	}
+	restore, err := v.prepareBody()
+	defer restore()
+	if err == nil {
+		// form parse errors other than the body size are ignored
+		if err = v.parseForm(); !isBodyTooLarge(err) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return url.Values{"_error": []string{v.bodyError(err)}}
+	}
+
+	return v.validateValues(v.Opts.Request.Form)
+}
+
+// ValidateValues validate the provided values like a parsed form or query string without any request
+// e.g: a message from a queue or a parsed config
+func (v *Validator) ValidateValues(values url.Values) url.Values {
+	if len(v.Opts.Rules) == 0 {
+		panic(errRequireRules)
+	}
+	if values == nil {
+		values = url.Values{}
+	}
+
+	return v.validateValues(values)
+}
+
+// ValidateForm decode form-data, x-www-form-urlencoded and query params into the struct provided in Options.Data
+// and validate the typed values against the rules
+// the conversion failures like a non numeric value for an int field are reported as field errors
+func (v *Validator) ValidateForm() url.Values {
+	if !v.hasRules() || v.Opts.Request == nil {
+		panic(errValidateArgsMismatch)
+	}
+	if v.Opts.Data == nil {
+		panic(errRequireData)
+	}
+	if reflect.TypeOf(v.Opts.Data).Kind() != reflect.Ptr {
+		panic(errRequirePtr)
+	}
+	restore, err := v.prepareBody()
+	defer restore()
+	if err == nil {
+		if err = v.parseForm(); !isBodyTooLarge(err) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return url.Values{"_error": []string{v.bodyError(err)}}
+	}
+
+	return v.validateFormData(v.Opts.Request.Form)
+}
+
+// validateFormData decode the form values into Options.Data and validate the typed values against the rules
+func (v *Validator) validateFormData(inputs url.Values) url.Values {
+	tag := tagIdentifier
+	if v.Opts.TagIdentifier != "" {
+		tag = v.Opts.TagIdentifier
+	}
+	// the rules of a field failed to convert are skipped, the conversion error is reported instead
+	convErrs := v.decodeForm(normalizeFormKeys(inputs, nil), v.Opts.Data, tag)
+	// the file: rules validate the uploaded files like Validate, the rest validate the data
+	rules := make(MapData, len(v.Opts.Rules))
+	fileErrs := url.Values{}
+	loc := v.newLocalizer()
+	for field, list := range v.Opts.Rules {
+		if !strings.HasPrefix(field, "file:") {
+			rules[field] = list
+			continue
+		}
+		for _, rule := range list {
+			if !isRuleExist(rule) {
+				panic(fmt.Errorf("govalidator: %s is not a valid rule", rule))
+			}
+			v.validateFile(field, rule, v.getCustomMessage(field, rule), fileErrs, loc)
+		}
+	}
+	errsBag := v.validateData(v.Opts.Data, rules, tag, convErrs)
+	mergeErrors(errsBag, fileErrs)
+	return errsBag
+}
+
+// validateValues validate the form values against the rules
+func (v *Validator) validateValues(inputs url.Values) url.Values {
 	errsBag := url.Values{}
+	inputs = normalizeFormKeys(inputs, v.Opts.Rules)
+	formRules := expandFormRules(v.Opts.Rules, inputs)
 
 	// get non required rules
-	nr := v.getNonRequiredFields()
+	nr := v.getNonRequiredFields(formRules, inputs)
+	loc := v.newLocalizer()
 
-	for field, rules := range v.Opts.Rules {
+	for field, rules := range formRules {
 		if _, ok := nr[field]; ok {
 			continue
 		}
@@ -94,18 +208,42 @@ func (v *Validator) Validate() url.Values {
 			msg := v.getCustomMessage(field, rule)
 			// validate file
 			if strings.HasPrefix(field, "file:") {
-				fld := strings.TrimPrefix(field, "file:")
-				file, fh, _ := v.Opts.Request.FormFile(fld)
-				if file != nil && fh.Filename != "" {
-					validateFiles(v.Opts.Request, fld, rule, msg, errsBag)
-					validateCustomRules(fld, rule, msg, file, errsBag)
-				} else {
-					validateCustomRules(fld, rule, msg, nil, errsBag)
-				}
+				v.validateFile(field, rule, msg, errsBag, loc)
 			} else {
+				fld, elemRule, each := getEachRule(field, rule)
+				inputVals, multi := formValues(inputs, fld)
+				vals := make([]string, len(inputVals))
+				for i, val := range inputVals {
+					vals[i] = strings.TrimSpace(val)
+				}
+				if each {
+					elems := make([]interface{}, len(vals))
+					for i, val := range vals {
+						elems[i] = numericValue(rules, elemRule, val)
+					}
+					v.validateEach(field, fld, elemRule, elems, errsBag, loc)
+					continue
+				}
+				if multi && !isIn(collectionRules, strings.Split(rule, ":")[0]) {
+					// the other rules of multi valued field validate every value, the first invalid value is reported
+					for _, val := range vals {
+						n := len(errsBag[field])
+						validateRule(field, loc.attribute(field), rule, msg, numericValue(rules, rule, val), errsBag, loc.templates)
+						if len(errsBag[field]) > n {
+							break
+						}
+					}
+					continue
+				}
+				// multi valued field is validated as a collection
+				var reqVal interface{} = ""
+				if multi {
+					reqVal = vals
+				} else if len(vals) > 0 {
+					reqVal = numericValue(rules, rule, vals[0])
+				}
 				// validate if custom rules exist
-				reqVal := strings.TrimSpace(v.Opts.Request.Form.Get(field))
-				validateCustomRules(field, rule, msg, reqVal, errsBag)
+				validateRule(field, loc.attribute(field), rule, msg, reqVal, errsBag, loc.templates)
 			}
 		}
 	}
@@ -113,21 +251,45 @@ func (v *Validator) Validate() url.Values {
 	return errsBag
 }
 
-// getNonRequiredFields remove non required rules fields from rules if requiredDefault field is false
-// and if the input data is empty for this field
-func (v *Validator) getNonRequiredFields() map[string]struct{} {
-	if v.Opts.FormSize > 0 {
-		_ = v.Opts.Request.ParseMultipartForm(v.Opts.FormSize)
+// validateFile validate the rule against the uploaded file of the file: prefixed field e.g: file:photo
+func (v *Validator) validateFile(field, rule, msg string, errsBag url.Values, loc *localizer) {
+	fld := strings.TrimPrefix(field, "file:")
+	attr := loc.attribute(fld)
+	if v.Opts.Request == nil {
+		validateRule(fld, attr, rule, msg, nil, errsBag, loc.templates)
+		return
+	}
+	file, fh, _ := v.Opts.Request.FormFile(fld)
+	if file != nil && fh.Filename != "" {
+		validateFiles(v.Opts.Request, fld, attr, rule, msg, errsBag, loc.templates)
+		validateRule(fld, attr, rule, msg, file, errsBag, loc.templates)
 	} else {
-		_ = v.Opts.Request.ParseMultipartForm(defaultFormSize)
+		validateRule(fld, attr, rule, msg, nil, errsBag, loc.templates)
+	}
+}
+
+// parseForm parse the request form-data, x-www-form-urlencoded and query params
+func (v *Validator) parseForm() error {
+	// ParseMultipartForm does not report the x-www-form-urlencoded body read error
+	err := v.Opts.Request.ParseForm()
+	formSize := defaultFormSize
+	if v.Opts.FormSize > 0 {
+		formSize = v.Opts.FormSize
+	}
+	if mErr := v.Opts.Request.ParseMultipartForm(formSize); err == nil {
+		err = mErr
 	}
+	return err
+}
 
-	inputs := v.Opts.Request.Form
+// getNonRequiredFields remove non required rules fields from rules if requiredDefault field is false
+// and if the input data is empty for this field
+func (v *Validator) getNonRequiredFields(rules MapData, inputs url.Values) map[string]struct{} {
 	nr := make(map[string]struct{})
 	if !v.Opts.RequiredDefault {
-		for k, r := range v.Opts.Rules {
+		for k, r := range rules {
 			isFile := strings.HasPrefix(k, "file:")
-			if _, ok := inputs[k]; !ok && !isFile {
+			if !isFormFieldExist(inputs, k) && !isFile {
 				if !isContainRequiredField(r) {
 					nr[k] = struct{}{}
 				}
@@ -137,21 +299,88 @@ func (v *Validator) getNonRequiredFields() map[string]struct{} {
 	return nr
 }
 
+// hasRules check if rules are provided in options or the data structure validates itself
+func (v *Validator) hasRules() bool {
+	if len(v.Opts.Rules) > 0 {
+		return true
+	}
+	switch v.Opts.Data.(type) {
+	case RulesProvider, Validatable:
+		return true
+	}
+	return false
+}
+
 // ValidateJSON validate request data from JSON body to Go struct
 // see example in README.md file
 func (v *Validator) ValidateJSON() url.Values {
-	if len(v.Opts.Rules) == 0 || v.Opts.Request == nil {
+	if !v.hasRules() || v.Opts.Request == nil {
+		panic(errValidateArgsMismatch)
+	}
+	if reflect.TypeOf(v.Opts.Data).Kind() != reflect.Ptr {
+		panic(errRequirePtr)
+	}
+
+	decode, _ := v.getDecoder("application/json")
+	return v.internalValidateStruct(decode, tagIdentifier)
+}
+
+// ValidateXML validate request data from XML body to Go struct
+// the xml struct tags including attributes and chardata are used as field names unless Options.TagIdentifier is provided
+func (v *Validator) ValidateXML() url.Values {
+	if !v.hasRules() || v.Opts.Request == nil {
+		panic(errValidateArgsMismatch)
+	}
+	if reflect.TypeOf(v.Opts.Data).Kind() != reflect.Ptr {
+		panic(errRequirePtr)
+	}
+
+	return v.internalValidateStruct(decodeXML, xmlTagIdentifier)
+}
+
+// ValidateRequest validate the request by dispatching on the Content-Type header
+// form-data, x-www-form-urlencoded and query params are validated like Validate
+// other bodies are decoded into Options.Data using the registered decoders, see AddDecoder
+func (v *Validator) ValidateRequest() url.Values {
+	if !v.hasRules() || v.Opts.Request == nil {
 		panic(errValidateArgsMismatch)
 	}
+	errsBag := url.Values{}
+
+	mediaType, err := parseMediaType(v.Opts.Request.Header.Get("Content-Type"))
+	if err != nil {
+		errsBag.Add("_error", err.Error())
+		return errsBag
+	}
+	if isFormMediaType(mediaType) {
+		return v.Validate()
+	}
+
+	decode, ok := v.getDecoder(mediaType)
+	if !ok {
+		errsBag.Add("_error", unsupportedMediaType(mediaType))
+		return errsBag
+	}
+	if v.Opts.Data == nil {
+		if isXMLMediaType(mediaType) {
+			errsBag.Add("_error", errXMLRequireData.Error())
+			return errsBag
+		}
+		// the body is decoded into a map without changing the options of the caller
+		local := *v
+		local.Opts.Data = &map[string]interface{}{}
+		return local.internalValidateStruct(decode, tagIdentifierOf(mediaType))
+	}
 	if reflect.TypeOf(v.Opts.Data).Kind() != reflect.Ptr {
 		panic(errRequirePtr)
 	}
 
-	return v.internalValidateStruct()
+	return v.internalValidateStruct(decode, tagIdentifierOf(mediaType))
 }
 
+// ValidateStruct validate the data structure provided in Options.Data without any request
 func (v *Validator) ValidateStruct() url.Values {
-	if len(v.Opts.Rules) == 0 {
+	if !v.hasRules() {
 		panic(errRequireRules)
 	}
 	if v.Opts.Request != nil {
@@ -164,56 +393,215 @@ func (v *Validator) ValidateStruct() url.Values {
 		panic(errRequireData)
 	}
 
-	return v.internalValidateStruct()
+	return v.internalValidateStruct(nil, tagIdentifier)
+}
+
+// getDecoder return the decoder of the media type registered by AddDecoder
+// JSON body is decoded by the validator to report the decode errors per field unless a decoder is registered
+func (v *Validator) getDecoder(mediaType string) (func(io.Reader, interface{}) error, bool) {
+	if fn, ok := getDecoder(mediaType); ok {
+		return fn, true
+	}
+	if isJSONMediaType(mediaType) {
+		return v.decodeJSON, true
+	}
+	return nil, false
+}
+
+// ruleKey return the key of the rule validating the field of the decoded path, e.g: zip for address.zip
+// the rules keyed by the path or its wildcard key are preferred, the path is returned if no rule is found
+// decode errors are reported by this key so they match the errors of the rules
+func (v *Validator) ruleKey(path string) string {
+	rulesList := []MapData{v.Opts.Rules}
+	if rp, ok := v.Opts.Data.(RulesProvider); ok {
+		rulesList = append(rulesList, rp.Rules())
+	}
+	for _, rules := range rulesList {
+		if _, ok := rules[path]; ok {
+			return path
+		}
+		if _, ok := rules[wildcardKey(path)]; ok {
+			return path
+		}
+	}
+	leaf := path[strings.LastIndex(path, ".")+1:]
+	for _, rules := range rulesList {
+		if _, ok := rules[leaf]; ok {
+			return leaf
+		}
+	}
+	return path
+}
+
+// internalValidateStruct decode the request body using the decoder if any and validate the data
+// defaultTag is used to read the struct tags unless Options.TagIdentifier is provided
+func (v *Validator) internalValidateStruct(decode func(io.Reader, interface{}) error, defaultTag string) url.Values {
+	errsBag := url.Values{}
+
+	if v.Opts.Request != nil && v.Opts.Request.Body != nil && v.Opts.Request.Body != http.NoBody && decode != nil {
+		restore, err := v.prepareBody()
+		defer restore()
+		if err == nil {
+			defer v.Opts.Request.Body.Close()
+			err = decode(v.Opts.Request.Body, v.Opts.Data)
+		}
+		if fe, ok := err.(fieldErrors); ok {
+			mergeErrors(errsBag, url.Values(fe))
+		} else if err != nil {
+			errsBag.Add("_error", v.bodyError(err))
+			return errsBag
+		}
+	}
+
+	return v.validateData(v.Opts.Data, v.Opts.Rules, defaultTag, errsBag)
+}
+
+// ValidateMap validate the provided map without any request
+// e.g: a message from a queue or a parsed config
+func (v *Validator) ValidateMap(data map[string]interface{}) url.Values {
+	if len(v.Opts.Rules) == 0 {
+		panic(errRequireRules)
+	}
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	return v.validateData(data, v.Opts.Rules, tagIdentifier, url.Values{})
 }
 
-func (v *Validator) internalValidateStruct() url.Values {
+// ValidateBytes validate the raw body of the provided content type without any request
+// the body is decoded into Options.Data if provided otherwise into a map, see AddDecoder
+// text/plain is not decoded as JSON, an unsupported media type error is returned
+func (v *Validator) ValidateBytes(data []byte, contentType string) url.Values {
+	if !v.hasRules() {
+		panic(errRequireRules)
+	}
+	if v.Opts.Data != nil && reflect.TypeOf(v.Opts.Data).Kind() != reflect.Ptr {
+		panic(errRequirePtr)
+	}
 	errsBag := url.Values{}
 
-	if v.Opts.Request != nil && v.Opts.Request.Body != http.NoBody {
-		defer v.Opts.Request.Body.Close()
-		err := json.NewDecoder(v.Opts.Request.Body).Decode(v.Opts.Data)
+	mediaType, err := parseMediaType(contentType)
+	if err != nil {
+		errsBag.Add("_error", err.Error())
+		return errsBag
+	}
+
+	if mediaType == "application/x-www-form-urlencoded" {
+		values, err := url.ParseQuery(string(data))
 		if err != nil {
 			errsBag.Add("_error", err.Error())
 			return errsBag
 		}
+		// the form is bound to the data structure like ValidateForm, the rules it provides are validated too
+		if v.Opts.Data != nil {
+			return v.validateFormData(values)
+		}
+		return v.validateValues(values)
+	}
+
+	decode, ok := v.getDecoder(mediaType)
+	if !ok {
+		errsBag.Add("_error", unsupportedMediaType(mediaType))
+		return errsBag
+	}
+	var target interface{} = v.Opts.Data
+	if target == nil {
+		if isXMLMediaType(mediaType) {
+			errsBag.Add("_error", errXMLRequireData.Error())
+			return errsBag
+		}
+		target = &map[string]interface{}{}
+	}
+	if len(data) > 0 {
+		err := decode(bytes.NewReader(data), target)
+		if fe, ok := err.(fieldErrors); ok {
+			mergeErrors(errsBag, url.Values(fe))
+		} else if err != nil {
+			errsBag.Add("_error", err.Error())
+			return errsBag
+		}
 	}
 
+	return v.validateData(target, v.Opts.Rules, tagIdentifierOf(mediaType), errsBag)
+}
+
+// validateData flatten the data and validate it against the rules
+// errsBag holds the decode errors if any, the rules of those fields are not validated
+func (v *Validator) validateData(data interface{}, rules MapData, defaultTag string, errsBag url.Values) url.Values {
+	skip := make(map[string]struct{}, len(errsBag))
+	for field := range errsBag {
+		skip[field] = struct{}{}
+	}
 	r := roller{}
-	r.setTagIdentifier(tagIdentifier)
+	r.setTagIdentifier(defaultTag)
 	if v.Opts.TagIdentifier != "" {
 		r.setTagIdentifier(v.Opts.TagIdentifier)
 	}
 	r.setTagSeparator(tagSeparator)
-	r.start(v.Opts.Data)
+	r.start(data)
+
+	loc := v.newLocalizer()
+	v.validateFlatRules(rules, &r, errsBag, skip, loc)
+	// struct level validation run after the field rules
+	v.validateSelf(&r, errsBag, skip, loc)
 
+	return errsBag
+}
+
+// validateFlatRules validate the rules against the flatten values of roller
+// the dotted keys are validated against the nested values e.g: address.city and the wildcard keys
+// are expanded like the form e.g: items.*.sku becomes items.0.sku, items.1.sku
+// the fields in skip failed to decode and are not validated
+func (v *Validator) validateFlatRules(rules MapData, r *roller, errsBag url.Values, skip map[string]struct{}, loc *localizer) {
+	rules = expandRules(rules, r.childSegments)
 	//clean if the key is not exist or value is empty or zero value
-	nr := v.getNonRequiredJSONFields(r.getFlatMap())
+	nr := v.getNonRequiredJSONFields(rules, r)
 
-	for field, rules := range v.Opts.Rules {
+	for field, rules := range rules {
 		if _, ok := nr[field]; ok {
 			continue
 		}
-		value, _ := r.getFlatVal(field)
+		if _, ok := skip[field]; ok {
+			continue
+		}
 		for _, rule := range rules {
 			if !isRuleExist(rule) {
 				panic(fmt.Errorf("govalidator: %s is not a valid rule", rule))
 			}
+			if fld, elemRule, each := getEachRule(field, rule); each {
+				value, _ := r.getFlatVal(fld)
+				v.validateEach(field, fld, elemRule, value, errsBag, loc)
+				continue
+			}
+			value, ok := r.getFlatVal(field)
+			if !ok {
+				// a nested map or struct is validated by the required and the type rules only
+				obj, isObj := r.getObjectVal(field)
+				if isObj && rule != "required" && !isIn(strictTypeRules, rule) {
+					continue
+				}
+				value = obj
+			}
 			msg := v.getCustomMessage(field, rule)
-			validateCustomRules(field, rule, msg, value, errsBag)
+			validateRule(field, loc.attribute(field), rule, msg, value, errsBag, loc.templates)
 		}
 	}
-
-	return errsBag
 }
 
 // getNonRequiredJSONFields get non required rules fields from rules if requiredDefault field is false
 // and if the input data is empty for this field
-func (v *Validator) getNonRequiredJSONFields(inputs map[string]interface{}) map[string]struct{} {
+func (v *Validator) getNonRequiredJSONFields(rules MapData, inputs *roller) map[string]struct{} {
 	nr := make(map[string]struct{})
 	if !v.Opts.RequiredDefault {
-		for k, r := range v.Opts.Rules {
-			if val := inputs[k]; isEmpty(val) {
+		for k, r := range rules {
+			// null wrapper like Nullable or sql.NullString is considered as empty
+			val, ok := inputs.getObjectVal(strings.TrimSuffix(k, wildcardSuffix))
+			if ok && isIn(r, "null") {
+				// the null rule validates the empty values if present
+				continue
+			}
+			if isEmpty(val) || validationValue(val) == nil {
 				if !isContainRequiredField(r) {
 					nr[k] = struct{}{}
 				}
@@ -3,8 +3,10 @@ package govalidator
 import (
 	"bytes"
 	"encoding/json"
+	"encoding/xml"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -243,6 +245,33 @@ func TestValidator_ValidateStruct(t *testing.T) {
 	}
 }
 
+func TestValidator_ValidateStruct_DottedKeys(t *testing.T) {
+	type Item struct {
+		SKU string `json:"sku"`
+	}
+	type Order struct {
+		Address struct {
+			City string `json:"city"`
+			Zip  string `json:"zip"`
+		} `json:"address"`
+		Items []Item `json:"items"`
+	}
+	order := Order{Items: []Item{{SKU: "A-1"}, {}}}
+	order.Address.Zip = "12"
+
+	errs := New(Options{
+		Data: &order,
+		Rules: MapData{
+			"address.city": []string{"required"},
+			"address.zip":  []string{"len:4"},
+			"items.*.sku":  []string{"required", "alpha_dash"},
+		},
+	}).ValidateStruct()
+	if len(errs) != 3 || errs.Get("address.city") == "" || errs.Get("address.zip") == "" || errs.Get("items.1.sku") == "" {
+		t.Errorf("ValidateStruct failed to validate the dotted keys: %v", errs)
+	}
+}
+
 func TestValidator_ValidateJSON_NoRules_panic(t *testing.T) {
 	opts := Options{}
 
@@ -321,3 +350,130 @@ func TestValidator_ValidateStruct_DataNil_panic(t *testing.T) {
 		New(opts).ValidateStruct()
 	})
 }
+
+func TestValidator_ValidateValues(t *testing.T) {
+	values := url.Values{}
+	values.Add("name", "John Doe")
+	values.Add("zip", "82")
+
+	opts := Options{
+		Rules: MapData{
+			"name":  []string{"required"},
+			"email": []string{"email"},
+			"zip":   []string{"digits:4"},
+			"age":   []string{"required"},
+		},
+	}
+
+	validationErr := New(opts).ValidateValues(values)
+	if len(validationErr) != 2 {
+		t.Log(validationErr)
+		t.Error("ValidateValues failed")
+	}
+}
+
+func TestValidator_ValidateMap(t *testing.T) {
+	data := map[string]interface{}{
+		"name":  "John Doe",
+		"email": "invalid email",
+		"age":   12,
+	}
+
+	opts := Options{
+		Rules: MapData{
+			"name":  []string{"required"},
+			"email": []string{"email"},
+			"age":   []string{"numeric_between:18,60"},
+			"zip":   []string{"digits:4"},
+		},
+	}
+
+	validationErr := New(opts).ValidateMap(data)
+	if len(validationErr) != 2 {
+		t.Log(validationErr)
+		t.Error("ValidateMap failed")
+	}
+}
+
+func TestValidator_ValidateBytes(t *testing.T) {
+	rules := MapData{
+		"name":  []string{"required"},
+		"email": []string{"email"},
+	}
+
+	validationErr := New(Options{Rules: rules}).ValidateBytes([]byte(`{"email":"invalid"}`), "application/json; charset=utf-8")
+	if len(validationErr) != 2 {
+		t.Log(validationErr)
+		t.Error("ValidateBytes failed for JSON body")
+	}
+
+	validationErr = New(Options{Rules: rules}).ValidateBytes([]byte("name=John&email=john@mail.com"), "application/x-www-form-urlencoded")
+	if len(validationErr) != 0 {
+		t.Log(validationErr)
+		t.Error("ValidateBytes failed for form body")
+	}
+
+	validationErr = New(Options{Rules: rules}).ValidateBytes([]byte("name: John"), "application/yaml")
+	if validationErr.Get("_error") == "" {
+		t.Error("ValidateBytes failed to report unsupported content type")
+	}
+
+	validationErr = New(Options{Rules: rules}).ValidateBytes([]byte(`{"name":"John"}`), "text/plain")
+	if !strings.Contains(validationErr.Get("_error"), "text/plain") {
+		t.Log(validationErr)
+		t.Error("ValidateBytes decoded text/plain as JSON")
+	}
+}
+
+func TestValidator_ValidateBytes_FormRulesProvider(t *testing.T) {
+	var c customer
+	validationErr := New(Options{Data: &c}).ValidateBytes([]byte("zip=12&contact.phone=01700000000"), "application/x-www-form-urlencoded")
+	if len(validationErr) != 1 || validationErr.Get("zip") == "" {
+		t.Log(validationErr)
+		t.Error("ValidateBytes failed to validate form body with RulesProvider rules only")
+	}
+}
+
+func TestValidator_ValidateValues_NoRules_panic(t *testing.T) {
+	assertPanicWith(t, errRequireRules, func() {
+		New(Options{}).ValidateValues(url.Values{})
+	})
+}
+
+//============ validate xml test ====================
+
+func TestValidator_ValidateXML(t *testing.T) {
+	type Price struct {
+		Currency string `xml:"currency,attr"`
+		Amount   string `xml:",chardata"`
+	}
+	type Order struct {
+		XMLName xml.Name `xml:"order"`
+		ID      string   `xml:"id,attr"`
+		Email   string   `xml:"customer>email"`
+		Price   Price    `xml:"price"`
+	}
+
+	rules := MapData{
+		"id":       []string{"required", "digits:4"},
+		"email":    []string{"email"},
+		"currency": []string{"in:USD,BDT"},
+		"price":    []string{"numeric"},
+	}
+
+	body := `<order id="12"><customer><email>invalid</email></customer><price currency="EUR">ten</price></order>`
+	req, _ := http.NewRequest("POST", "http://www.example.com", strings.NewReader(body))
+
+	var order Order
+	validationErr := New(Options{Request: req, Data: &order, Rules: rules}).ValidateXML()
+	if len(validationErr) != 4 {
+		t.Log(validationErr)
+		t.Error("ValidateXML failed")
+	}
+
+	req, _ = http.NewRequest("POST", "http://www.example.com", strings.NewReader("<order"))
+	validationErr = New(Options{Request: req, Data: &order, Rules: rules}).ValidateXML()
+	if validationErr.Get("_error") == "" {
+		t.Error("ValidateXML failed to report decode error")
+	}
+}
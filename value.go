@@ -0,0 +1,86 @@
+package govalidator
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"strings"
+)
+
+// ValidationValuer is implemented by the types which provide the value to validate
+// e.g: a wrapper of a primitive type, the rules validate the returned value instead of the wrapper
+type ValidationValuer interface {
+	ValidationValue() interface{}
+}
+
+// maxUnwrapDepth prevent infinite unwrapping of a value returning itself
+const maxUnwrapDepth = 8
+
+// validationValue extract the value to validate by the rules
+// pointers are dereferenced, Nullable, sql.Null* types and ValidationValuer are unwrapped
+// nil is returned for a nil pointer or a null wrapper
+func validationValue(value interface{}) interface{} {
+	for i := 0; i < maxUnwrapDepth && isValueWrapper(value); i++ {
+		if rv := reflect.ValueOf(value); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return nil
+		}
+		if isSQLNull(value) {
+			val, err := value.(driver.Valuer).Value()
+			if err != nil {
+				return value
+			}
+			value = val
+			continue
+		}
+		switch v := value.(type) {
+		case ValidationValuer:
+			value = v.ValidationValue()
+		case nullable:
+			val, ok := v.nullableValue()
+			if !ok {
+				return nil
+			}
+			value = val
+		default:
+			value = reflect.ValueOf(value).Elem().Interface()
+		}
+	}
+	return value
+}
+
+// isValueWrapper check if the value is unwrapped by validationValue
+// pointers to map and struct other than the wrappers and time.Time are kept as is e.g: multipart.File
+func isValueWrapper(value interface{}) bool {
+	switch value.(type) {
+	case nil:
+		return false
+	case ValidationValuer, nullable:
+		return true
+	}
+	if isSQLNull(value) {
+		return true
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Ptr {
+		return false
+	}
+	switch rv.Type().Elem().Kind() {
+	case reflect.Struct:
+		return rv.Type().Elem() == timeType
+	case reflect.Map:
+		return false
+	}
+	return true
+}
+
+// isSQLNull check if the value is a sql.Null* type e.g: sql.NullString or sql.Null[T]
+// the other types implementing driver.Valuer like a JSONB struct are validated as they are
+func isSQLNull(value interface{}) bool {
+	if _, ok := value.(driver.Valuer); !ok {
+		return false
+	}
+	t := reflect.TypeOf(value)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.PkgPath() == "database/sql" && strings.HasPrefix(t.Name(), "Null")
+}
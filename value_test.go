@@ -0,0 +1,112 @@
+package govalidator
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+)
+
+type celsius struct {
+	degree float64
+}
+
+func (c celsius) ValidationValue() interface{} {
+	return c.degree
+}
+
+// jsonbAddress represents a struct stored as JSONB column, it is not unwrapped by driver.Valuer
+type jsonbAddress struct {
+	City string `json:"city"`
+}
+
+func (a jsonbAddress) Value() (driver.Value, error) {
+	return json.Marshal(a)
+}
+
+func Test_validationValue(t *testing.T) {
+	age := 20
+	var nilAge *int
+	list := map[string]struct {
+		value    interface{}
+		expected interface{}
+	}{
+		"pointer":      {&age, 20},
+		"nil pointer":  {nilAge, nil},
+		"nullable":     {NewNullable(5), 5},
+		"null":         {Int{Null: true}, nil},
+		"nullable ptr": {&Float64{V: 1.5, IsSet: true}, 1.5},
+		"sql":          {sql.NullString{String: "x", Valid: true}, "x"},
+		"sql null":     {sql.NullInt64{}, nil},
+		"sql generic":  {sql.Null[int]{V: 3, Valid: true}, int64(3)},
+		"valuer":       {celsius{degree: 30}, 30.0},
+		"plain":        {"john", "john"},
+	}
+	for name, l := range list {
+		if v := validationValue(l.value); v != l.expected {
+			t.Errorf("validationValue failed for %s: expected %v got %v", name, l.expected, v)
+		}
+	}
+}
+
+func TestValidator_ValidateStruct_UnwrapValues(t *testing.T) {
+	zero := 0
+	big := 150
+	type user struct {
+		Age       Int             `json:"age"`
+		Count     *int            `json:"count"`
+		Limit     *int            `json:"limit"`
+		Nick      sql.NullString  `json:"nick"`
+		Temp      celsius         `json:"temp"`
+		Score     Float64         `json:"score"`
+		Email     String          `json:"email"`
+		Reference sql.NullInt64   `json:"reference"`
+		Optional  *Nullable[bool] `json:"optional"`
+	}
+	data := user{
+		Age:   NewNullable(10),
+		Count: &zero,
+		Limit: &big,
+		Nick:  sql.NullString{String: "jo", Valid: true},
+		Temp:  celsius{degree: 120},
+		Score: Float64{Null: true},
+	}
+	opts := Options{
+		Data: &data,
+		Rules: MapData{
+			"age":       []string{"required", "min:18"},
+			"count":     []string{"required"},
+			"limit":     []string{"numeric_between:1,100"},
+			"nick":      []string{"between:3,10"},
+			"temp":      []string{"max:100"},
+			"score":     []string{"min:1"},
+			"email":     []string{"email"},
+			"reference": []string{"required"},
+		},
+	}
+
+	validationErr := New(opts).ValidateStruct()
+	expected := []string{"age", "limit", "nick", "temp", "reference"}
+	if len(validationErr) != len(expected) {
+		t.Log(validationErr)
+		t.Error("ValidateStruct failed to validate the unwrapped values")
+	}
+	for _, field := range expected {
+		if validationErr.Get(field) == "" {
+			t.Errorf("ValidateStruct failed to validate %s", field)
+		}
+	}
+}
+
+func TestValidator_ValidateStruct_NestedValuer(t *testing.T) {
+	type user struct {
+		Address jsonbAddress `json:"address"`
+	}
+	rules := MapData{"city": []string{"required"}, "address": []string{"required", "object"}}
+	if errs := New(Options{Data: &user{Address: jsonbAddress{City: "Dhaka"}}, Rules: rules}).ValidateStruct(); len(errs) != 0 {
+		t.Errorf("ValidateStruct failed to traverse the struct implementing driver.Valuer: %v", errs)
+	}
+	if errs := New(Options{Data: &user{}, Rules: rules}).ValidateStruct(); errs.Get("city") == "" {
+		t.Errorf("ValidateStruct failed to validate the field of the struct implementing driver.Valuer: %v", errs)
+	}
+}